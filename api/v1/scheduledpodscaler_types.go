@@ -17,6 +17,7 @@ limitations under the License.
 package v1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -28,23 +29,117 @@ type ScheduledPodScalerSpec struct {
 	ScaleTarget      ScaleTarget `json:"scaleTarget,omitempty"`
 	ScaleRules       []ScaleRule `json:"schedule,omitempty"`
 	DefaultScaleSpec ScaleSpec   `json:"default,omitempty"`
+	// DefaultMode is the behavior when no rule is active, one of spec or restore, default to spec.
+	// If spec is set, the controller scales the targets to DefaultScaleSpec.
+	// If restore is set, the controller records the original replicas of each target before applying a rule,
+	// and restores them when no rule is active. DefaultScaleSpec is ignored.
+	// +optional
+	DefaultMode string `json:"defaultMode,omitempty"`
+	// ConflictPolicy determines the rule to apply when multiple rules are active,
+	// one of first, highestPriority, maxReplicas or minReplicas, default to first.
+	// If first is set, the first active rule in the schedule is applied.
+	// If highestPriority is set, the active rule of the highest priority is applied.
+	// If maxReplicas or minReplicas is set, the active rule of the most or fewest replicas is applied.
+	// If the rules are tied, the first one is applied.
+	// +optional
+	ConflictPolicy string `json:"conflictPolicy,omitempty"`
+	// DryRun computes the desired replicas and reports them to the status and events without scaling the targets.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+	// Suspend stops scaling of the targets and keeps the current replicas.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+	// SuspendUntil resumes scaling automatically at the time in RFC3339, e.g. 2019-12-24T09:00:00+09:00.
+	// This is effective only while Suspend is true.
+	// +optional
+	SuspendUntil string `json:"suspendUntil,omitempty"`
+	// RampUp is the default policy to increase the replicas, used if the active rule has no policy.
+	// +optional
+	RampUp *RampPolicy `json:"rampUp,omitempty"`
+	// RampDown is the default policy to decrease the replicas, used if the active rule has no policy.
+	// +optional
+	RampDown *RampPolicy `json:"rampDown,omitempty"`
+	// ScaleDownDelay is the default duration to wait before decreasing the replicas, such as 10m.
+	// +optional
+	ScaleDownDelay string `json:"scaleDownDelay,omitempty"`
 }
 
 // ScaleTarget represents the resource to scale.
-// For now only Deployment is supported.
+// The resource must have the scale subresource, e.g. Deployment, StatefulSet or ReplicaSet.
+// If the kind is HorizontalPodAutoscaler, it scales minReplicas and maxReplicas of the HorizontalPodAutoscaler.
 type ScaleTarget struct {
+	// APIVersion of the resource, default to apps/v1.
+	// +optional
+	APIVersion string `json:"apiVersion,omitempty"`
+	// Kind of the resource, default to Deployment.
+	// +optional
+	Kind string `json:"kind,omitempty"`
+	// Name of the resource.
+	// This is mutually exclusive with Selectors and Selector.
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Selectors is a set of labels to find the resources.
+	// Deprecated: use Selector instead.
 	// +optional
 	Selectors map[string]string `json:"selectors,omitempty"`
+	// Selector finds the resources by the label selector.
+	// If both Selectors and Selector are given, a resource must match both.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+	// NamespaceSelector selects the namespaces to find the resources.
+	// Default to the namespace of the ScheduledPodScaler.
+	// Set an empty selector {} to find the resources in all namespaces.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
 }
 
 // ScaleRule represents a rule of scaling schedule.
 type ScaleRule struct {
+	// Name of the rule shown in the status and events, such as weekday-daytime.
+	// It must be a DNS label and unique in the schedule, default to schedule[index].
+	// +optional
+	Name      string    `json:"name,omitempty"`
 	ScaleSpec ScaleSpec `json:"spec,omitempty"`
 	// Timezone, default to UTC.
 	// +optional
 	Timezone string `json:"timezone,omitempty"`
 	// +optional
 	Daily *DailyRule `json:"daily,omitempty"`
+	// +optional
+	Weekly *WeeklyRule `json:"weekly,omitempty"`
+	// +optional
+	Cron *CronRule `json:"cron,omitempty"`
+	// +optional
+	Absolute *AbsoluteRule `json:"absolute,omitempty"`
+	// Mode of the replicas, one of exact, atLeast or atMost, default to exact.
+	// If atLeast is set, the controller does not decrease the replicas below the current replicas.
+	// If atMost is set, the controller does not increase the replicas above the current replicas.
+	// +optional
+	Mode string `json:"mode,omitempty"`
+	// Priority of the rule, used if the conflictPolicy is highestPriority.
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+	// LeadTime starts the rule earlier by the duration, such as 10m.
+	// This is useful to make the pods ready at the start time.
+	// +optional
+	LeadTime string `json:"leadTime,omitempty"`
+	// RampUp is the policy to increase the replicas while the rule is active.
+	// +optional
+	RampUp *RampPolicy `json:"rampUp,omitempty"`
+	// RampDown is the policy to decrease the replicas while the rule is active.
+	// +optional
+	RampDown *RampPolicy `json:"rampDown,omitempty"`
+	// ScaleDownDelay is the duration to wait before decreasing the replicas while the rule is active.
+	// +optional
+	ScaleDownDelay string `json:"scaleDownDelay,omitempty"`
+}
+
+// RampPolicy represents a policy to change the replicas step by step.
+type RampPolicy struct {
+	// MaxStep is the maximum number of replicas to change in a step.
+	MaxStep int32 `json:"maxStep"`
+	// Interval between the steps, such as 1m.
+	Interval string `json:"interval"`
 }
 
 // DailyRule represents a rule to apply everyday.
@@ -55,9 +150,74 @@ type DailyRule struct {
 	EndTime   string `json:"endTime,omitempty"`
 }
 
+// WeeklyRule represents a rule to apply on the days of week.
+type WeeklyRule struct {
+	// Days of week such as Monday or Mon.
+	Days []string `json:"days,omitempty"`
+	// Time format in 00:00:00.
+	// If EndTime < StartTime, it treats the EndTime as the next day.
+	StartTime string `json:"startTime,omitempty"`
+	EndTime   string `json:"endTime,omitempty"`
+}
+
+// CronRule represents a rule to apply on the cron schedule.
+type CronRule struct {
+	// Cron expression to start the rule, such as 0 8 * * 1-5.
+	Start string `json:"start,omitempty"`
+	// Cron expression to end the rule.
+	// Either End or Duration must be set.
+	// +optional
+	End string `json:"end,omitempty"`
+	// Duration from the start, such as 12h.
+	// Either End or Duration must be set.
+	// +optional
+	Duration string `json:"duration,omitempty"`
+}
+
+// AbsoluteRule represents a rule to apply only once in the period.
+// Either StartTime and EndTime, or StartDate and EndDate must be set.
+type AbsoluteRule struct {
+	// Time format in RFC3339, e.g. 2019-12-24T09:00:00+09:00.
+	// +optional
+	StartTime string `json:"startTime,omitempty"`
+	// +optional
+	EndTime string `json:"endTime,omitempty"`
+	// Date format in 2019-12-24.
+	// It treats the EndDate as inclusive, i.e. the rule ends at the midnight of the next day.
+	// It is mutually exclusive with StartTime and EndTime.
+	// +optional
+	StartDate string `json:"startDate,omitempty"`
+	// +optional
+	EndDate string `json:"endDate,omitempty"`
+}
+
 // ScaleSpec represents the desired state to scale the resource.
 type ScaleSpec struct {
 	Replicas int32 `json:"replicas,omitempty"`
+	// MinReplicas of the HorizontalPodAutoscaler.
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// MaxReplicas of the HorizontalPodAutoscaler.
+	// +optional
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+	// Percentage of the baseline replicas of each target.
+	// If this is set, Replicas is ignored.
+	// +optional
+	Percentage *PercentageSpec `json:"percentage,omitempty"`
+}
+
+// PercentageSpec represents the replicas relative to the baseline replicas of each target.
+// The baseline is the annotation scheduledscaling.int128.github.io/baseline-replicas of the target.
+// If the target has no annotation, the controller records the current replicas to it before scaling.
+type PercentageSpec struct {
+	// Value of the percentage, such as 25.
+	Value int32 `json:"value"`
+	// Rounding of the computed replicas, one of up, down or nearest, default to up.
+	// +optional
+	Rounding string `json:"rounding,omitempty"`
+	// Min is the minimum replicas.
+	// +optional
+	Min int32 `json:"min,omitempty"`
 }
 
 // ScheduledPodScalerStatus defines the observed state of ScheduledPodScaler
@@ -66,10 +226,90 @@ type ScheduledPodScalerStatus struct {
 	// Important: Run "make" to regenerate code after modifying this file
 
 	NextReconcileTime string `json:"nextReconcileTime,omitempty"`
+	// ActiveRule is the name of the active rule, e.g. weekday-daytime or schedule[0], or default.
+	// +optional
+	ActiveRule string `json:"activeRule,omitempty"`
+	// DesiredReplicas is the replicas of the active rule.
+	// +optional
+	DesiredReplicas *int32 `json:"desiredReplicas,omitempty"`
+	// DesiredPercentage is the percentage of the baseline replicas of the active rule.
+	// +optional
+	DesiredPercentage *int32 `json:"desiredPercentage,omitempty"`
+	// DesiredMinReplicas is the minReplicas of the active rule for HorizontalPodAutoscaler.
+	// +optional
+	DesiredMinReplicas *int32 `json:"desiredMinReplicas,omitempty"`
+	// DesiredMaxReplicas is the maxReplicas of the active rule for HorizontalPodAutoscaler.
+	// +optional
+	DesiredMaxReplicas *int32 `json:"desiredMaxReplicas,omitempty"`
+	// LastScaleTime is the last time when the controller has changed the replicas of a target.
+	// +optional
+	LastScaleTime string `json:"lastScaleTime,omitempty"`
+	// Targets is a list of the targets found by the scaleTarget.
+	// +optional
+	Targets []ScheduledPodScalerTargetStatus `json:"targets,omitempty"`
+	// +optional
+	Conditions []ScheduledPodScalerCondition `json:"conditions,omitempty"`
+}
+
+// ScheduledPodScalerTargetStatus represents the status of a target.
+type ScheduledPodScalerTargetStatus struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// Replicas is the observed replicas of the target before scaling.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// +optional
+	DesiredReplicas *int32 `json:"desiredReplicas,omitempty"`
+	// MinReplicas is the observed minReplicas of the HorizontalPodAutoscaler before scaling.
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// +optional
+	DesiredMinReplicas *int32 `json:"desiredMinReplicas,omitempty"`
+	// MaxReplicas is the observed maxReplicas of the HorizontalPodAutoscaler before scaling.
+	// +optional
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+	// +optional
+	DesiredMaxReplicas *int32 `json:"desiredMaxReplicas,omitempty"`
+	// LastScaleTime is the last time when the controller has changed the replicas of the target.
+	// +optional
+	LastScaleTime string `json:"lastScaleTime,omitempty"`
+	// Error is the message of the last error on scaling the target.
+	// +optional
+	Error string `json:"error,omitempty"`
+	// Skipped is the reason why the controller did not scale the target, e.g. the target has the ignore annotation.
+	// +optional
+	Skipped string `json:"skipped,omitempty"`
+	// NextStepTime is the time of the next step while the target is ramping to the desired replicas.
+	// +optional
+	NextStepTime string `json:"nextStepTime,omitempty"`
+	// ScaleDownTime is the time when the controller decreases the replicas after the delay.
+	// +optional
+	ScaleDownTime string `json:"scaleDownTime,omitempty"`
+}
+
+// ScheduledPodScalerCondition represents a condition of the ScheduledPodScaler.
+type ScheduledPodScalerCondition struct {
+	// Type of the condition, one of Ready, ScheduleValid, TargetsFound, Scaled or Suspended.
+	Type string `json:"type"`
+	// Status of the condition, one of True, False or Unknown.
+	Status corev1.ConditionStatus `json:"status"`
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="REASON",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].reason",priority=1
+// +kubebuilder:printcolumn:name="ACTIVE-RULE",type="string",JSONPath=".status.activeRule"
+// +kubebuilder:printcolumn:name="DESIRED",type="integer",JSONPath=".status.desiredReplicas"
+// +kubebuilder:printcolumn:name="NEXT-RECONCILE",type="string",JSONPath=".status.nextReconcileTime"
+// +kubebuilder:printcolumn:name="LAST-SCALE",type="string",JSONPath=".status.lastScaleTime",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 
 // ScheduledPodScaler is the Schema for the scheduledpodscalers API
 type ScheduledPodScaler struct {
@@ -21,9 +21,40 @@ limitations under the License.
 package v1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AbsoluteRule) DeepCopyInto(out *AbsoluteRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AbsoluteRule.
+func (in *AbsoluteRule) DeepCopy() *AbsoluteRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AbsoluteRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronRule) DeepCopyInto(out *CronRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronRule.
+func (in *CronRule) DeepCopy() *CronRule {
+	if in == nil {
+		return nil
+	}
+	out := new(CronRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DailyRule) DeepCopyInto(out *DailyRule) {
 	*out = *in
@@ -39,15 +70,70 @@ func (in *DailyRule) DeepCopy() *DailyRule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PercentageSpec) DeepCopyInto(out *PercentageSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PercentageSpec.
+func (in *PercentageSpec) DeepCopy() *PercentageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PercentageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RampPolicy) DeepCopyInto(out *RampPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RampPolicy.
+func (in *RampPolicy) DeepCopy() *RampPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RampPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScaleRule) DeepCopyInto(out *ScaleRule) {
 	*out = *in
-	out.ScaleSpec = in.ScaleSpec
+	in.ScaleSpec.DeepCopyInto(&out.ScaleSpec)
 	if in.Daily != nil {
 		in, out := &in.Daily, &out.Daily
 		*out = new(DailyRule)
 		**out = **in
 	}
+	if in.Weekly != nil {
+		in, out := &in.Weekly, &out.Weekly
+		*out = new(WeeklyRule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cron != nil {
+		in, out := &in.Cron, &out.Cron
+		*out = new(CronRule)
+		**out = **in
+	}
+	if in.Absolute != nil {
+		in, out := &in.Absolute, &out.Absolute
+		*out = new(AbsoluteRule)
+		**out = **in
+	}
+	if in.RampUp != nil {
+		in, out := &in.RampUp, &out.RampUp
+		*out = new(RampPolicy)
+		**out = **in
+	}
+	if in.RampDown != nil {
+		in, out := &in.RampDown, &out.RampDown
+		*out = new(RampPolicy)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaleRule.
@@ -63,6 +149,21 @@ func (in *ScaleRule) DeepCopy() *ScaleRule {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScaleSpec) DeepCopyInto(out *ScaleSpec) {
 	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Percentage != nil {
+		in, out := &in.Percentage, &out.Percentage
+		*out = new(PercentageSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaleSpec.
@@ -85,6 +186,16 @@ func (in *ScaleTarget) DeepCopyInto(out *ScaleTarget) {
 			(*out)[key] = val
 		}
 	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaleTarget.
@@ -103,7 +214,7 @@ func (in *ScheduledPodScaler) DeepCopyInto(out *ScheduledPodScaler) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduledPodScaler.
@@ -124,6 +235,22 @@ func (in *ScheduledPodScaler) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduledPodScalerCondition) DeepCopyInto(out *ScheduledPodScalerCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduledPodScalerCondition.
+func (in *ScheduledPodScalerCondition) DeepCopy() *ScheduledPodScalerCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduledPodScalerCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScheduledPodScalerList) DeepCopyInto(out *ScheduledPodScalerList) {
 	*out = *in
@@ -167,7 +294,17 @@ func (in *ScheduledPodScalerSpec) DeepCopyInto(out *ScheduledPodScalerSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	out.DefaultScaleSpec = in.DefaultScaleSpec
+	in.DefaultScaleSpec.DeepCopyInto(&out.DefaultScaleSpec)
+	if in.RampUp != nil {
+		in, out := &in.RampUp, &out.RampUp
+		*out = new(RampPolicy)
+		**out = **in
+	}
+	if in.RampDown != nil {
+		in, out := &in.RampDown, &out.RampDown
+		*out = new(RampPolicy)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduledPodScalerSpec.
@@ -183,6 +320,40 @@ func (in *ScheduledPodScalerSpec) DeepCopy() *ScheduledPodScalerSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScheduledPodScalerStatus) DeepCopyInto(out *ScheduledPodScalerStatus) {
 	*out = *in
+	if in.DesiredReplicas != nil {
+		in, out := &in.DesiredReplicas, &out.DesiredReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DesiredPercentage != nil {
+		in, out := &in.DesiredPercentage, &out.DesiredPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DesiredMinReplicas != nil {
+		in, out := &in.DesiredMinReplicas, &out.DesiredMinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DesiredMaxReplicas != nil {
+		in, out := &in.DesiredMaxReplicas, &out.DesiredMaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]ScheduledPodScalerTargetStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]ScheduledPodScalerCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduledPodScalerStatus.
@@ -194,3 +365,68 @@ func (in *ScheduledPodScalerStatus) DeepCopy() *ScheduledPodScalerStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduledPodScalerTargetStatus) DeepCopyInto(out *ScheduledPodScalerTargetStatus) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DesiredReplicas != nil {
+		in, out := &in.DesiredReplicas, &out.DesiredReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DesiredMinReplicas != nil {
+		in, out := &in.DesiredMinReplicas, &out.DesiredMinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DesiredMaxReplicas != nil {
+		in, out := &in.DesiredMaxReplicas, &out.DesiredMaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduledPodScalerTargetStatus.
+func (in *ScheduledPodScalerTargetStatus) DeepCopy() *ScheduledPodScalerTargetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduledPodScalerTargetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WeeklyRule) DeepCopyInto(out *WeeklyRule) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WeeklyRule.
+func (in *WeeklyRule) DeepCopy() *WeeklyRule {
+	if in == nil {
+		return nil
+	}
+	out := new(WeeklyRule)
+	in.DeepCopyInto(out)
+	return out
+}
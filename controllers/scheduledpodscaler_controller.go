@@ -21,7 +21,12 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/int128/scheduled-scaler/pkg/di"
+	"github.com/int128/scheduled-scaler/pkg/infrastructure/metrics"
+	"github.com/int128/scheduled-scaler/pkg/usecases/reconcile"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/scale"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -32,20 +37,32 @@ import (
 // ScheduledPodScalerReconciler reconciles a ScheduledPodScaler object
 type ScheduledPodScalerReconciler struct {
 	client.Client
-	Log    logr.Logger
-	Scheme *runtime.Scheme
+	Log         logr.Logger
+	Scheme      *runtime.Scheme
+	RESTMapper  meta.RESTMapper
+	ScaleClient scale.ScalesGetter
+	Recorder    record.EventRecorder
+	Metrics     metrics.Interface
+	DryRun      bool
 }
 
 // +kubebuilder:rbac:groups=scheduledscaling.int128.github.io,resources=scheduledpodscalers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=scheduledscaling.int128.github.io,resources=scheduledpodscalers/status,verbs=get;update;patch
 
-// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=list;watch;patch
+// +kubebuilder:rbac:groups=apps,resources=deployments;statefulsets;replicasets,verbs=get;list;patch
+// +kubebuilder:rbac:groups=apps,resources=deployments/scale;statefulsets/scale;replicasets/scale,verbs=get;patch
+// +kubebuilder:rbac:groups=argoproj.io,resources=rollouts,verbs=get;list;patch
+// +kubebuilder:rbac:groups=argoproj.io,resources=rollouts/scale,verbs=get;patch
+// +kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 func (r *ScheduledPodScalerReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	ctx := context.Background()
 	log := r.Log.WithValues("scheduledpodscaler", req.NamespacedName)
 
-	c := di.NewController(log, &clock.RealClock{}, r.Client)
+	c := di.NewController(log, &clock.RealClock{}, r.Client, r.RESTMapper, r.ScaleClient, r.Recorder, r.Metrics,
+		reconcile.Options{DryRun: r.DryRun})
 	return c.Reconcile(ctx, req)
 }
 
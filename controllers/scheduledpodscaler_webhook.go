@@ -0,0 +1,64 @@
+/*
+Copyright 2019 Hidetake Iwata.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	"github.com/int128/scheduled-scaler/pkg/repositories/scheduledpodscaler"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	scheduledscalingv1 "github.com/int128/scheduled-scaler/api/v1"
+)
+
+const scheduledPodScalerValidatorPath = "/validate-scheduledscaling-int128-github-io-v1-scheduledpodscaler"
+
+// +kubebuilder:webhook:path=/validate-scheduledscaling-int128-github-io-v1-scheduledpodscaler,mutating=false,failurePolicy=fail,groups=scheduledscaling.int128.github.io,resources=scheduledpodscalers,verbs=create;update,versions=v1,name=vscheduledpodscaler.scheduledscaling.int128.github.io
+
+// ScheduledPodScalerValidator validates a ScheduledPodScaler object
+type ScheduledPodScalerValidator struct {
+	Log     logr.Logger
+	decoder *admission.Decoder
+}
+
+// Handle rejects the object if the spec cannot be parsed by the controller.
+func (v *ScheduledPodScalerValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	var o scheduledscalingv1.ScheduledPodScaler
+	if err := v.decoder.Decode(req, &o); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	if _, err := scheduledpodscaler.ParseSpec(o.Spec); err != nil {
+		v.Log.Info("denied the object", "namespace", req.Namespace, "name", req.Name, "error", err)
+		return admission.Denied(err.Error())
+	}
+	return admission.Allowed("")
+}
+
+// InjectDecoder injects the decoder.
+func (v *ScheduledPodScalerValidator) InjectDecoder(d *admission.Decoder) error {
+	v.decoder = d
+	return nil
+}
+
+func (v *ScheduledPodScalerValidator) SetupWithManager(mgr ctrl.Manager) error {
+	mgr.GetWebhookServer().Register(scheduledPodScalerValidatorPath, &webhook.Admission{Handler: v})
+	return nil
+}
@@ -8,20 +8,28 @@ import (
 	"github.com/google/wire"
 	"github.com/int128/scheduled-scaler/pkg/infrastructure/clock"
 	"github.com/int128/scheduled-scaler/pkg/infrastructure/controller"
-	"github.com/int128/scheduled-scaler/pkg/repositories/deployment"
+	"github.com/int128/scheduled-scaler/pkg/infrastructure/metrics"
+	"github.com/int128/scheduled-scaler/pkg/repositories/horizontalpodautoscaler"
+	"github.com/int128/scheduled-scaler/pkg/repositories/namespace"
 	"github.com/int128/scheduled-scaler/pkg/repositories/scheduledpodscaler"
+	"github.com/int128/scheduled-scaler/pkg/repositories/workload"
 	"github.com/int128/scheduled-scaler/pkg/usecases/reconcile"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/scale"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func NewController(logr.Logger, clock.Interface, client.Client) controller.Interface {
+func NewController(logr.Logger, clock.Interface, client.Client, meta.RESTMapper, scale.ScalesGetter, record.EventRecorder, metrics.Interface, reconcile.Options) controller.Interface {
 	wire.Build(
 		// usecases
 		reconcile.Set,
 
 		// repositories
 		scheduledpodscaler.Set,
-		deployment.Set,
+		workload.Set,
+		horizontalpodautoscaler.Set,
+		namespace.Set,
 
 		// infrastructure
 		controller.Set,
@@ -9,30 +9,50 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/int128/scheduled-scaler/pkg/infrastructure/clock"
 	"github.com/int128/scheduled-scaler/pkg/infrastructure/controller"
-	"github.com/int128/scheduled-scaler/pkg/repositories/deployment"
+	"github.com/int128/scheduled-scaler/pkg/infrastructure/metrics"
+	"github.com/int128/scheduled-scaler/pkg/repositories/horizontalpodautoscaler"
+	"github.com/int128/scheduled-scaler/pkg/repositories/namespace"
 	"github.com/int128/scheduled-scaler/pkg/repositories/scheduledpodscaler"
+	"github.com/int128/scheduled-scaler/pkg/repositories/workload"
 	"github.com/int128/scheduled-scaler/pkg/usecases/reconcile"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/scale"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // Injectors from di.go:
 
-func NewController(logger logr.Logger, clockInterface clock.Interface, clientClient client.Client) controller.Interface {
+func NewController(logger logr.Logger, clockInterface clock.Interface, clientClient client.Client, restMapper meta.RESTMapper, scalesGetter scale.ScalesGetter, eventRecorder record.EventRecorder, metricsInterface metrics.Interface, options reconcile.Options) controller.Interface {
 	repository := &scheduledpodscaler.Repository{
 		Client: clientClient,
 	}
-	deploymentRepository := &deployment.Repository{
+	workloadRepository := &workload.Repository{
+		Client:      clientClient,
+		RESTMapper:  restMapper,
+		ScaleClient: scalesGetter,
+	}
+	horizontalpodautoscalerRepository := &horizontalpodautoscaler.Repository{
+		Client: clientClient,
+	}
+	namespaceRepository := &namespace.Repository{
 		Client: clientClient,
 	}
 	reconcileReconcile := &reconcile.Reconcile{
-		Log:                          logger,
-		Clock:                        clockInterface,
-		ScheduledPodScalerRepository: repository,
-		DeploymentRepository:         deploymentRepository,
+		Log:                               logger,
+		Clock:                             clockInterface,
+		Recorder:                          eventRecorder,
+		Metrics:                           metricsInterface,
+		Options:                           options,
+		ScheduledPodScalerRepository:      repository,
+		WorkloadRepository:                workloadRepository,
+		HorizontalPodAutoscalerRepository: horizontalpodautoscalerRepository,
+		NamespaceRepository:               namespaceRepository,
 	}
 	controllerController := &controller.Controller{
 		Log:     logger,
 		UseCase: reconcileReconcile,
+		Metrics: metricsInterface,
 	}
 	return controllerController
 }
@@ -31,3 +31,31 @@ func IsNotFound(err error) bool {
 	}
 	return false
 }
+
+// Invalid represents a resource has an invalid spec.
+type Invalid interface {
+	error
+	IsInvalid() bool
+}
+
+func IsInvalid(err error) bool {
+	var e Invalid
+	if xerrors.As(err, &e) {
+		return e.IsInvalid()
+	}
+	return false
+}
+
+// UnknownKind represents the kind of a resource is not served by the cluster.
+type UnknownKind interface {
+	error
+	IsUnknownKind() bool
+}
+
+func IsUnknownKind(err error) bool {
+	var e UnknownKind
+	if xerrors.As(err, &e) {
+		return e.IsUnknownKind()
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package schedule
+
+import (
+	"time"
+
+	"golang.org/x/xerrors"
+)
+
+// NewAbsoluteRange returns an AbsoluteRange with the given times in RFC3339.
+func NewAbsoluteRange(startTime, endTime string) (*AbsoluteRange, error) {
+	s, err := time.Parse(time.RFC3339, startTime)
+	if err != nil {
+		return nil, xerrors.Errorf("could not parse the startTime: %w", err)
+	}
+	e, err := time.Parse(time.RFC3339, endTime)
+	if err != nil {
+		return nil, xerrors.Errorf("could not parse the endTime: %w", err)
+	}
+	if !s.Before(e) {
+		return nil, xerrors.Errorf("endTime must be after startTime")
+	}
+	return &AbsoluteRange{StartTime: s, EndTime: e}, nil
+}
+
+// NewAbsoluteDateRange returns an AbsoluteRange with the given dates in the location.
+// It treats the endDate as inclusive.
+// For example, if startDate=2019-12-24 and endDate=2019-12-25 are given,
+// the range is from 2019-12-24T00:00:00 to 2019-12-26T00:00:00.
+func NewAbsoluteDateRange(startDate, endDate string, loc *time.Location) (*AbsoluteRange, error) {
+	s, err := time.ParseInLocation("2006-01-02", startDate, loc)
+	if err != nil {
+		return nil, xerrors.Errorf("could not parse the startDate: %w", err)
+	}
+	e, err := time.ParseInLocation("2006-01-02", endDate, loc)
+	if err != nil {
+		return nil, xerrors.Errorf("could not parse the endDate: %w", err)
+	}
+	if e.Before(s) {
+		return nil, xerrors.Errorf("endDate must not be before startDate")
+	}
+	return &AbsoluteRange{StartTime: s, EndTime: e.AddDate(0, 0, 1)}, nil
+}
+
+// AbsoluteRange represents a one-off schedule.
+type AbsoluteRange struct {
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+// IsActive returns true if t is in the range.
+func (a *AbsoluteRange) IsActive(t time.Time) bool {
+	return !t.Before(a.StartTime) && t.Before(a.EndTime)
+}
+
+// NextEdge returns the StartTime or EndTime after now.
+// It returns zero if the range has already ended.
+func (a *AbsoluteRange) NextEdge(now time.Time) time.Time {
+	if now.Before(a.StartTime) {
+		return a.StartTime.In(now.Location())
+	}
+	if now.Before(a.EndTime) {
+		return a.EndTime.In(now.Location())
+	}
+	return time.Time{}
+}
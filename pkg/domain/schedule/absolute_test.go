@@ -0,0 +1,156 @@
+package schedule_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/int128/scheduled-scaler/pkg/domain/schedule"
+)
+
+func TestNewAbsoluteRange(t *testing.T) {
+	t.Run("StartTime<EndTime", func(t *testing.T) {
+		got, err := schedule.NewAbsoluteRange("2019-12-24T09:00:00Z", "2019-12-25T21:00:00Z")
+		if err != nil {
+			t.Errorf("NewAbsoluteRange error: %s", err)
+		}
+		want := &schedule.AbsoluteRange{
+			StartTime: time.Date(2019, 12, 24, 9, 0, 0, 0, time.UTC),
+			EndTime:   time.Date(2019, 12, 25, 21, 0, 0, 0, time.UTC),
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("EndTime<StartTime", func(t *testing.T) {
+		got, err := schedule.NewAbsoluteRange("2019-12-25T21:00:00Z", "2019-12-24T09:00:00Z")
+		if got != nil {
+			t.Errorf("NewAbsoluteRange wants nil but %+v", got)
+		}
+		if err == nil {
+			t.Errorf("NewAbsoluteRange wants error but nil")
+		}
+	})
+	t.Run("InvalidStartTime", func(t *testing.T) {
+		got, err := schedule.NewAbsoluteRange("2019-12-24 09:00:00", "2019-12-25T21:00:00Z")
+		if got != nil {
+			t.Errorf("NewAbsoluteRange wants nil but %+v", got)
+		}
+		if err == nil {
+			t.Errorf("NewAbsoluteRange wants error but nil")
+		}
+	})
+}
+
+func TestNewAbsoluteDateRange(t *testing.T) {
+	for _, tz := range timezones {
+		t.Run(tz.String(), func(t *testing.T) {
+			t.Run("StartDate<EndDate", func(t *testing.T) {
+				got, err := schedule.NewAbsoluteDateRange("2019-12-24", "2019-12-25", tz)
+				if err != nil {
+					t.Errorf("NewAbsoluteDateRange error: %s", err)
+				}
+				want := &schedule.AbsoluteRange{
+					StartTime: time.Date(2019, 12, 24, 0, 0, 0, 0, tz),
+					EndTime:   time.Date(2019, 12, 26, 0, 0, 0, 0, tz),
+				}
+				if diff := cmp.Diff(want, got); diff != "" {
+					t.Errorf("mismatch (-want +got):\n%s", diff)
+				}
+			})
+			t.Run("StartDate=EndDate", func(t *testing.T) {
+				got, err := schedule.NewAbsoluteDateRange("2019-12-24", "2019-12-24", tz)
+				if err != nil {
+					t.Errorf("NewAbsoluteDateRange error: %s", err)
+				}
+				want := &schedule.AbsoluteRange{
+					StartTime: time.Date(2019, 12, 24, 0, 0, 0, 0, tz),
+					EndTime:   time.Date(2019, 12, 25, 0, 0, 0, 0, tz),
+				}
+				if diff := cmp.Diff(want, got); diff != "" {
+					t.Errorf("mismatch (-want +got):\n%s", diff)
+				}
+			})
+			t.Run("EndDate<StartDate", func(t *testing.T) {
+				got, err := schedule.NewAbsoluteDateRange("2019-12-25", "2019-12-24", tz)
+				if got != nil {
+					t.Errorf("NewAbsoluteDateRange wants nil but %+v", got)
+				}
+				if err == nil {
+					t.Errorf("NewAbsoluteDateRange wants error but nil")
+				}
+			})
+			t.Run("InvalidStartDate", func(t *testing.T) {
+				got, err := schedule.NewAbsoluteDateRange("2019/12/24", "2019-12-25", tz)
+				if got != nil {
+					t.Errorf("NewAbsoluteDateRange wants nil but %+v", got)
+				}
+				if err == nil {
+					t.Errorf("NewAbsoluteDateRange wants error but nil")
+				}
+			})
+		})
+	}
+}
+
+func TestAbsoluteRange_IsActive(t *testing.T) {
+	tests := func(t *testing.T, tz *time.Location) {
+		absolute, err := schedule.NewAbsoluteDateRange("2019-12-24", "2019-12-25", tz)
+		if err != nil {
+			t.Fatalf("NewAbsoluteDateRange error: %s", err)
+		}
+		for _, c := range []struct {
+			name string
+			now  time.Time
+			want bool
+		}{
+			{"BeforeRange", time.Date(2019, 12, 23, 23, 0, 0, 0, tz), false},
+			{"InRange", time.Date(2019, 12, 25, 23, 0, 0, 0, tz), true},
+			{"AfterRange", time.Date(2019, 12, 26, 1, 0, 0, 0, tz), false},
+		} {
+			t.Run(c.name, func(t *testing.T) {
+				got := absolute.IsActive(c.now)
+				if got != c.want {
+					t.Errorf("IsActive wants %v but %v (absolute=%+v)", c.want, got, absolute)
+				}
+			})
+		}
+	}
+
+	for _, tz := range timezones {
+		t.Run(tz.String(), func(t *testing.T) {
+			tests(t, tz)
+		})
+	}
+}
+
+func TestAbsoluteRange_NextEdge(t *testing.T) {
+	tests := func(t *testing.T, tz *time.Location) {
+		absoluteRange, err := schedule.NewAbsoluteDateRange("2019-12-24", "2019-12-25", tz)
+		if err != nil {
+			t.Fatalf("NewAbsoluteDateRange error: %s", err)
+		}
+		for _, c := range []struct {
+			name string
+			now  time.Time
+			want time.Time
+		}{
+			{"BeforeStart", time.Date(2019, 12, 23, 23, 0, 0, 0, tz), time.Date(2019, 12, 24, 0, 0, 0, 0, tz)},
+			{"AfterStartBeforeEnd", time.Date(2019, 12, 25, 23, 0, 0, 0, tz), time.Date(2019, 12, 26, 0, 0, 0, 0, tz)},
+			{"AfterEnd", time.Date(2019, 12, 26, 1, 0, 0, 0, tz), time.Time{}},
+		} {
+			t.Run(c.name, func(t *testing.T) {
+				got := absoluteRange.NextEdge(c.now)
+				if diff := cmp.Diff(c.want, got); diff != "" {
+					t.Errorf("mismatch (-want +got):\n%s", diff)
+				}
+			})
+		}
+	}
+
+	for _, tz := range timezones {
+		t.Run(tz.String(), func(t *testing.T) {
+			tests(t, tz)
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package schedule
+
+import (
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"golang.org/x/xerrors"
+)
+
+// NewCronRange returns a CronRange with the given cron expressions.
+// The start must be a standard cron expression such as 0 8 * * 1-5.
+// Either the end cron expression or the duration such as 12h must be given.
+func NewCronRange(start, end, duration string) (*CronRange, error) {
+	s, err := parseCronExpression(start)
+	if err != nil {
+		return nil, xerrors.Errorf("could not parse the start: %w", err)
+	}
+	switch {
+	case end != "" && duration != "":
+		return nil, xerrors.New("either end or duration must be set but both are given")
+	case end != "":
+		e, err := parseCronExpression(end)
+		if err != nil {
+			return nil, xerrors.Errorf("could not parse the end: %w", err)
+		}
+		return &CronRange{Start: s, End: e}, nil
+	case duration != "":
+		d, err := time.ParseDuration(duration)
+		if err != nil {
+			return nil, xerrors.Errorf("could not parse the duration: %w", err)
+		}
+		if d <= 0 {
+			return nil, xerrors.Errorf("duration must be positive but was %s", d)
+		}
+		return &CronRange{Start: s, Duration: d}, nil
+	default:
+		return nil, xerrors.New("either end or duration must be set")
+	}
+}
+
+// cronParser accepts the 5 fields of the standard cron expression.
+// It does not accept a descriptor such as @every, because it is relative to the current time.
+var cronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+
+// parseCronExpression parses the standard cron expression.
+// It rejects a timezone prefix, because the expression is evaluated in the timezone of the rule.
+func parseCronExpression(expr string) (cron.Schedule, error) {
+	if strings.HasPrefix(expr, "TZ=") || strings.HasPrefix(expr, "CRON_TZ=") {
+		return nil, xerrors.New("timezone prefix is not allowed, set the timezone of the rule instead")
+	}
+	return cronParser.Parse(expr)
+}
+
+// CronRange represents a schedule of cron expressions.
+// The range starts at Start and ends at End or after Duration.
+// Both are computed in the timezone of the given time.
+type CronRange struct {
+	Start    cron.Schedule
+	End      cron.Schedule // nil if Duration is set
+	Duration time.Duration
+}
+
+// IsActive returns true if t is in the range.
+// This function depends on the timezone of t.
+func (c *CronRange) IsActive(t time.Time) bool {
+	if c.End == nil {
+		// the range is active if it started within the duration
+		s := c.Start.Next(t.Add(-c.Duration))
+		return !s.IsZero() && !s.After(t)
+	}
+	// the range is active if the last start comes after the last end,
+	// because the start and end may not come alternately, e.g. the start on weekdays and the end on everyday
+	s := lastOccurrence(c.Start, t)
+	if s.IsZero() {
+		return false
+	}
+	e := lastOccurrence(c.End, t)
+	return e.Before(s)
+}
+
+// lastOccurrenceWindows are the durations to search the last occurrence.
+// It starts from the short window to reduce the iterations of a frequent schedule.
+var lastOccurrenceWindows = []time.Duration{
+	time.Hour,
+	24 * time.Hour,
+	8 * 24 * time.Hour,
+	32 * 24 * time.Hour,
+	367 * 24 * time.Hour,
+}
+
+// lastOccurrence returns the last time of the schedule at or before t.
+// It returns zero if the schedule did not occur in the last year.
+func lastOccurrence(schedule cron.Schedule, t time.Time) time.Time {
+	for _, window := range lastOccurrenceWindows {
+		var last time.Time
+		for o := schedule.Next(t.Add(-window)); !o.IsZero() && !o.After(t); o = schedule.Next(o) {
+			last = o
+		}
+		if !last.IsZero() {
+			return last
+		}
+	}
+	return time.Time{}
+}
+
+// NextEdge returns the earliest start or end after now.
+// It returns zero if neither of them will come.
+func (c *CronRange) NextEdge(now time.Time) time.Time {
+	s := c.Start.Next(now)
+	if c.End == nil {
+		e := c.Start.Next(now.Add(-c.Duration))
+		if !e.IsZero() {
+			e = e.Add(c.Duration)
+		}
+		return earliest(s, e)
+	}
+	return earliest(s, c.End.Next(now))
+}
+
+func earliest(times ...time.Time) (e time.Time) {
+	for _, t := range times {
+		if t.IsZero() {
+			continue
+		}
+		if e.IsZero() || t.Before(e) {
+			e = t
+		}
+	}
+	return
+}
@@ -0,0 +1,154 @@
+package schedule_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/int128/scheduled-scaler/pkg/domain/schedule"
+)
+
+func TestNewCronRange(t *testing.T) {
+	t.Run("End", func(t *testing.T) {
+		got, err := schedule.NewCronRange("0 8 * * 1-5", "0 20 * * 1-5", "")
+		if err != nil {
+			t.Fatalf("NewCronRange error: %s", err)
+		}
+		if got.End == nil {
+			t.Errorf("End wants non-nil but nil")
+		}
+		if got.Duration != 0 {
+			t.Errorf("Duration wants 0 but %s", got.Duration)
+		}
+	})
+	t.Run("Duration", func(t *testing.T) {
+		got, err := schedule.NewCronRange("0 8 * * 1-5", "", "12h")
+		if err != nil {
+			t.Fatalf("NewCronRange error: %s", err)
+		}
+		if got.End != nil {
+			t.Errorf("End wants nil but %+v", got.End)
+		}
+		if got.Duration != 12*time.Hour {
+			t.Errorf("Duration wants 12h but %s", got.Duration)
+		}
+	})
+	for _, c := range []struct {
+		name                 string
+		start, end, duration string
+	}{
+		{"InvalidStart", "0 8 * *", "0 20 * * *", ""},
+		{"InvalidEnd", "0 8 * * *", "0 25 * * *", ""},
+		{"InvalidDuration", "0 8 * * *", "", "12"},
+		{"NegativeDuration", "0 8 * * *", "", "-12h"},
+		{"BothEndAndDuration", "0 8 * * *", "0 20 * * *", "12h"},
+		{"NeitherEndNorDuration", "0 8 * * *", "", ""},
+		{"EveryStart", "@every 1h", "", "30m"},
+		{"DescriptorEnd", "0 8 * * *", "@daily", ""},
+		{"TimezoneStart", "CRON_TZ=Asia/Tokyo 0 8 * * *", "0 20 * * *", ""},
+		{"TimezoneEnd", "0 8 * * *", "TZ=Asia/Tokyo 0 20 * * *", ""},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := schedule.NewCronRange(c.start, c.end, c.duration)
+			if got != nil {
+				t.Errorf("NewCronRange wants nil but %+v", got)
+			}
+			if err == nil {
+				t.Errorf("NewCronRange wants error but nil")
+			}
+		})
+	}
+}
+
+func TestCronRange_IsActive(t *testing.T) {
+	tests := func(t *testing.T, tz *time.Location) {
+		for _, c := range []struct {
+			name                 string
+			start, end, duration string
+		}{
+			{"End", "0 8 * * 1-5", "0 20 * * 1-5", ""},
+			{"Duration", "0 8 * * 1-5", "", "12h"},
+			// the end comes on the weekend without the start
+			{"EndOnEveryday", "0 8 * * 1-5", "0 20 * * *", ""},
+		} {
+			t.Run(c.name, func(t *testing.T) {
+				// Monday to Friday, 08:00 to 20:00
+				cronRange, err := schedule.NewCronRange(c.start, c.end, c.duration)
+				if err != nil {
+					t.Fatalf("NewCronRange error: %s", err)
+				}
+				for _, c := range []struct {
+					name string
+					now  time.Time
+					want bool
+				}{
+					{"AtStart/Monday", time.Date(2019, 12, 2, 8, 0, 0, 0, tz), true},
+					{"AtEnd/Monday", time.Date(2019, 12, 2, 20, 0, 0, 0, tz), false},
+					{"OutOfDays/SaturdayDaytime", time.Date(2019, 12, 7, 10, 0, 0, 0, tz), false},
+					{"OutOfDays/SundayNight", time.Date(2019, 12, 8, 21, 0, 0, 0, tz), false},
+					{"InRange/Monday", time.Date(2019, 12, 2, 9, 0, 0, 0, tz), true},
+					{"InRange/Friday", time.Date(2019, 12, 6, 19, 0, 0, 0, tz), true},
+					{"BeforeRange/Monday", time.Date(2019, 12, 2, 7, 0, 0, 0, tz), false},
+					{"AfterRange/Friday", time.Date(2019, 12, 6, 21, 0, 0, 0, tz), false},
+					{"OutOfDays/Saturday", time.Date(2019, 12, 7, 9, 0, 0, 0, tz), false},
+				} {
+					t.Run(c.name, func(t *testing.T) {
+						got := cronRange.IsActive(c.now)
+						if got != c.want {
+							t.Errorf("IsActive wants %v but %v", c.want, got)
+						}
+					})
+				}
+			})
+		}
+	}
+
+	for _, tz := range timezones {
+		t.Run(tz.String(), func(t *testing.T) {
+			tests(t, tz)
+		})
+	}
+}
+
+func TestCronRange_NextEdge(t *testing.T) {
+	tests := func(t *testing.T, tz *time.Location) {
+		for _, c := range []struct {
+			name                 string
+			start, end, duration string
+		}{
+			{"End", "0 8 * * 1-5", "0 20 * * 1-5", ""},
+			{"Duration", "0 8 * * 1-5", "", "12h"},
+		} {
+			t.Run(c.name, func(t *testing.T) {
+				// Monday to Friday, 08:00 to 20:00
+				cronRange, err := schedule.NewCronRange(c.start, c.end, c.duration)
+				if err != nil {
+					t.Fatalf("NewCronRange error: %s", err)
+				}
+				for _, c := range []struct {
+					name string
+					now  time.Time
+					want time.Time
+				}{
+					{"BeforeStart/Monday", time.Date(2019, 12, 2, 7, 0, 0, 0, tz), time.Date(2019, 12, 2, 8, 0, 0, 0, tz)},
+					{"AfterStartBeforeEnd/Monday", time.Date(2019, 12, 2, 9, 0, 0, 0, tz), time.Date(2019, 12, 2, 20, 0, 0, 0, tz)},
+					{"AfterEnd/Monday", time.Date(2019, 12, 2, 21, 0, 0, 0, tz), time.Date(2019, 12, 3, 8, 0, 0, 0, tz)},
+					{"AfterEnd/Friday", time.Date(2019, 12, 6, 21, 0, 0, 0, tz), time.Date(2019, 12, 9, 8, 0, 0, 0, tz)},
+				} {
+					t.Run(c.name, func(t *testing.T) {
+						got := cronRange.NextEdge(c.now)
+						if diff := cmp.Diff(c.want, got); diff != "" {
+							t.Errorf("mismatch (-want +got):\n%s", diff)
+						}
+					})
+				}
+			})
+		}
+	}
+
+	for _, tz := range timezones {
+		t.Run(tz.String(), func(t *testing.T) {
+			tests(t, tz)
+		})
+	}
+}
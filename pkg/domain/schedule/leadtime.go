@@ -0,0 +1,48 @@
+package schedule
+
+import "time"
+
+// LeadTimeRange represents a range which starts earlier by the LeadTime.
+// For example, if the Range is from 09:00 to 18:00 and LeadTime is 10m, it is active from 08:50 to 18:00.
+// The LeadTime may be longer than the duration of the Range,
+// e.g. if the Range is from 09:00 to 09:05 and LeadTime is 1h, it is active from 08:00 to 09:05.
+type LeadTimeRange struct {
+	Range    Range
+	LeadTime time.Duration
+}
+
+// IsActive returns true if t is in the Range or the Range starts within (t, t+LeadTime].
+func (r *LeadTimeRange) IsActive(t time.Time) bool {
+	until := t.Add(r.LeadTime)
+	if r.Range.IsActive(t) || r.Range.IsActive(until) {
+		return true
+	}
+	// the Range may start and end within the LeadTime,
+	// so check if it is active in each interval between the edges
+	for e := t; e.Before(until); {
+		next := r.Range.NextEdge(e.Add(time.Nanosecond))
+		if next.IsZero() || next.After(until) {
+			next = until
+		}
+		if r.Range.IsActive(e.Add(next.Sub(e) / 2)) {
+			return true
+		}
+		e = next
+	}
+	return false
+}
+
+// NextEdge returns the earlier of the next edge of the Range or the edge shifted by the LeadTime.
+// It may return an edge where the range is not changed, e.g. the start of the Range.
+func (r *LeadTimeRange) NextEdge(now time.Time) time.Time {
+	e := r.Range.NextEdge(now)
+	shifted := r.Range.NextEdge(now.Add(r.LeadTime))
+	if shifted.IsZero() {
+		return e
+	}
+	shifted = shifted.Add(-r.LeadTime)
+	if e.IsZero() || shifted.Before(e) {
+		return shifted
+	}
+	return e
+}
@@ -0,0 +1,113 @@
+package schedule_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/int128/scheduled-scaler/pkg/domain/schedule"
+)
+
+func TestLeadTimeRange_IsActive(t *testing.T) {
+	// 08:50:00 (today, 09:00:00 - 10m)
+	// 18:00:00 (today)
+	tests := func(t *testing.T, tz *time.Location) {
+		dailyRange, err := schedule.NewDailyRange("09:00:00", "18:00:00")
+		if err != nil {
+			t.Fatalf("NewDailyRange error: %s", err)
+		}
+		leadTimeRange := &schedule.LeadTimeRange{Range: dailyRange, LeadTime: 10 * time.Minute}
+		for name, c := range map[string]struct {
+			now  time.Time
+			want bool
+		}{
+			"BeforeLeadTime": {time.Date(2019, 12, 3, 8, 45, 0, 0, tz), false},
+			"InLeadTime":     {time.Date(2019, 12, 3, 8, 55, 0, 0, tz), true},
+			"InRange":        {time.Date(2019, 12, 3, 12, 0, 0, 0, tz), true},
+			"BeforeEnd":      {time.Date(2019, 12, 3, 17, 55, 0, 0, tz), true},
+			"AfterEnd":       {time.Date(2019, 12, 3, 18, 5, 0, 0, tz), false},
+		} {
+			t.Run(name, func(t *testing.T) {
+				got := leadTimeRange.IsActive(c.now)
+				if got != c.want {
+					t.Errorf("IsActive wants %v but %v", c.want, got)
+				}
+			})
+		}
+	}
+
+	for _, tz := range timezones {
+		t.Run(tz.String(), func(t *testing.T) {
+			tests(t, tz)
+		})
+	}
+}
+
+func TestLeadTimeRange_IsActive_LongerThanRange(t *testing.T) {
+	// 08:00:00 (today, 09:00:00 - 1h)
+	// 09:05:00 (today)
+	tests := func(t *testing.T, tz *time.Location) {
+		dailyRange, err := schedule.NewDailyRange("09:00:00", "09:05:00")
+		if err != nil {
+			t.Fatalf("NewDailyRange error: %s", err)
+		}
+		leadTimeRange := &schedule.LeadTimeRange{Range: dailyRange, LeadTime: time.Hour}
+		for name, c := range map[string]struct {
+			now  time.Time
+			want bool
+		}{
+			"BeforeLeadTime":   {time.Date(2019, 12, 3, 7, 55, 0, 0, tz), false},
+			"StartOfLeadTime":  {time.Date(2019, 12, 3, 8, 3, 0, 0, tz), true},
+			"MiddleOfLeadTime": {time.Date(2019, 12, 3, 8, 30, 0, 0, tz), true},
+			"EndOfLeadTime":    {time.Date(2019, 12, 3, 8, 59, 0, 0, tz), true},
+			"InRange":          {time.Date(2019, 12, 3, 9, 3, 0, 0, tz), true},
+			"AfterEnd":         {time.Date(2019, 12, 3, 9, 10, 0, 0, tz), false},
+		} {
+			t.Run(name, func(t *testing.T) {
+				got := leadTimeRange.IsActive(c.now)
+				if got != c.want {
+					t.Errorf("IsActive wants %v but %v", c.want, got)
+				}
+			})
+		}
+	}
+
+	for _, tz := range timezones {
+		t.Run(tz.String(), func(t *testing.T) {
+			tests(t, tz)
+		})
+	}
+}
+
+func TestLeadTimeRange_NextEdge(t *testing.T) {
+	tests := func(t *testing.T, tz *time.Location) {
+		dailyRange, err := schedule.NewDailyRange("09:00:00", "18:00:00")
+		if err != nil {
+			t.Fatalf("NewDailyRange error: %s", err)
+		}
+		leadTimeRange := &schedule.LeadTimeRange{Range: dailyRange, LeadTime: 10 * time.Minute}
+		for name, c := range map[string]struct {
+			now  time.Time
+			want time.Time
+		}{
+			"BeforeLeadTime": {time.Date(2019, 12, 3, 8, 0, 0, 0, tz), time.Date(2019, 12, 3, 8, 50, 0, 0, tz)},
+			"InLeadTime":     {time.Date(2019, 12, 3, 8, 55, 0, 0, tz), time.Date(2019, 12, 3, 9, 0, 0, 0, tz)},
+			"InRange":        {time.Date(2019, 12, 3, 12, 0, 0, 0, tz), time.Date(2019, 12, 3, 17, 50, 0, 0, tz)},
+			"BeforeEnd":      {time.Date(2019, 12, 3, 17, 55, 0, 0, tz), time.Date(2019, 12, 3, 18, 0, 0, 0, tz)},
+			"AfterEnd":       {time.Date(2019, 12, 3, 18, 5, 0, 0, tz), time.Date(2019, 12, 4, 8, 50, 0, 0, tz)},
+		} {
+			t.Run(name, func(t *testing.T) {
+				got := leadTimeRange.NextEdge(c.now)
+				if diff := cmp.Diff(c.want, got); diff != "" {
+					t.Errorf("mismatch (-want +got):\n%s", diff)
+				}
+			})
+		}
+	}
+
+	for _, tz := range timezones {
+		t.Run(tz.String(), func(t *testing.T) {
+			tests(t, tz)
+		})
+	}
+}
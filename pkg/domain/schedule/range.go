@@ -3,6 +3,7 @@ package schedule
 import "time"
 
 // Range represents a time range.
+// NextEdge returns zero if the range will never start or end after now.
 type Range interface {
 	IsActive(now time.Time) bool
 	NextEdge(now time.Time) time.Time
@@ -0,0 +1,100 @@
+package schedule
+
+import (
+	"strings"
+	"time"
+
+	"golang.org/x/xerrors"
+)
+
+// NewWeeklyRange returns a WeeklyRange with the given days of week and range.
+// Each day must be a name of the weekday such as Monday or Mon.
+// If endTime < startTime, it treats the endTime as the next day of each day.
+// For example, if Friday, startTime=23:00:00 and endTime=01:00:00 are given,
+// the range is from 23:00:00 on Friday to 01:00:00 on Saturday.
+func NewWeeklyRange(days []string, startTime, endTime string) (*WeeklyRange, error) {
+	if len(days) == 0 {
+		return nil, xerrors.New("days must have at least one day")
+	}
+	var weekdays []time.Weekday
+	for _, day := range days {
+		w, err := parseWeekday(day)
+		if err != nil {
+			return nil, xerrors.Errorf("could not parse the days: %w", err)
+		}
+		weekdays = append(weekdays, w)
+	}
+	daily, err := NewDailyRange(startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+	return &WeeklyRange{
+		Weekdays:  weekdays,
+		StartTime: daily.StartTime,
+		EndTime:   daily.EndTime,
+	}, nil
+}
+
+func parseWeekday(s string) (time.Weekday, error) {
+	for w := time.Sunday; w <= time.Saturday; w++ {
+		if strings.EqualFold(s, w.String()) || strings.EqualFold(s, w.String()[:3]) {
+			return w, nil
+		}
+	}
+	return 0, xerrors.Errorf("unknown day of week: %s", s)
+}
+
+// WeeklyRange represents a weekly schedule.
+// The range starts on each day of Weekdays.
+type WeeklyRange struct {
+	Weekdays  []time.Weekday
+	StartTime time.Duration
+	EndTime   time.Duration
+}
+
+// IsActive returns true if t is in the range.
+// This function depends on the timezone of t.
+func (w *WeeklyRange) IsActive(t time.Time) bool {
+	today := truncateDay(t)
+	// the range of yesterday may continue to today
+	for _, day := range []time.Time{today, today.AddDate(0, 0, -1)} {
+		if !w.includes(day.Weekday()) {
+			continue
+		}
+		since := t.Sub(day)
+		if w.StartTime < since && since < w.EndTime {
+			return true
+		}
+	}
+	return false
+}
+
+// NextEdge returns the earliest StartTime or EndTime after now.
+func (w *WeeklyRange) NextEdge(now time.Time) (earliest time.Time) {
+	today := truncateDay(now)
+	// from the range of yesterday to the range of the same weekday in the next week
+	for i := -1; i <= 7; i++ {
+		day := today.AddDate(0, 0, i)
+		if !w.includes(day.Weekday()) {
+			continue
+		}
+		for _, edge := range []time.Time{day.Add(w.StartTime), day.Add(w.EndTime)} {
+			if edge.Before(now) {
+				continue
+			}
+			if earliest.IsZero() || edge.Before(earliest) {
+				earliest = edge
+			}
+		}
+	}
+	return
+}
+
+func (w *WeeklyRange) includes(weekday time.Weekday) bool {
+	for _, d := range w.Weekdays {
+		if d == weekday {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,191 @@
+package schedule_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/int128/scheduled-scaler/pkg/domain/schedule"
+)
+
+func TestNewWeeklyRange(t *testing.T) {
+	t.Run("StartTime<EndTime", func(t *testing.T) {
+		got, err := schedule.NewWeeklyRange([]string{"Monday", "tue", "FRI"}, "08:00:00", "20:00:00")
+		if err != nil {
+			t.Errorf("NewWeeklyRange error: %s", err)
+		}
+		want := &schedule.WeeklyRange{
+			Weekdays:  []time.Weekday{time.Monday, time.Tuesday, time.Friday},
+			StartTime: 8 * time.Hour,
+			EndTime:   20 * time.Hour,
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("EndTime<StartTime", func(t *testing.T) {
+		got, err := schedule.NewWeeklyRange([]string{"Friday"}, "23:00:00", "01:00:00")
+		if err != nil {
+			t.Errorf("NewWeeklyRange error: %s", err)
+		}
+		want := &schedule.WeeklyRange{
+			Weekdays:  []time.Weekday{time.Friday},
+			StartTime: 23 * time.Hour,
+			EndTime:   25 * time.Hour,
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("NoDays", func(t *testing.T) {
+		got, err := schedule.NewWeeklyRange(nil, "08:00:00", "20:00:00")
+		if got != nil {
+			t.Errorf("NewWeeklyRange wants nil but %+v", got)
+		}
+		if err == nil {
+			t.Errorf("NewWeeklyRange wants error but nil")
+		}
+	})
+	t.Run("InvalidDay", func(t *testing.T) {
+		got, err := schedule.NewWeeklyRange([]string{"Monday", "Funday"}, "08:00:00", "20:00:00")
+		if got != nil {
+			t.Errorf("NewWeeklyRange wants nil but %+v", got)
+		}
+		if err == nil {
+			t.Errorf("NewWeeklyRange wants error but nil")
+		}
+	})
+	t.Run("InvalidStartTime", func(t *testing.T) {
+		got, err := schedule.NewWeeklyRange([]string{"Monday"}, "08:00", "20:00:00")
+		if got != nil {
+			t.Errorf("NewWeeklyRange wants nil but %+v", got)
+		}
+		if err == nil {
+			t.Errorf("NewWeeklyRange wants error but nil")
+		}
+	})
+}
+
+func TestWeeklyRange_IsActive(t *testing.T) {
+	tests := func(t *testing.T, tz *time.Location) {
+		t.Run("StartTime<EndTime", func(t *testing.T) {
+			// Monday to Friday, 08:00:00 to 20:00:00
+			weekly, err := schedule.NewWeeklyRange([]string{"Mon", "Tue", "Wed", "Thu", "Fri"}, "08:00:00", "20:00:00")
+			if err != nil {
+				t.Fatalf("NewWeeklyRange error: %s", err)
+			}
+			for _, c := range []struct {
+				name string
+				now  time.Time
+				want bool
+			}{
+				{"InRange/Monday", time.Date(2019, 12, 2, 9, 0, 0, 0, tz), true},
+				{"InRange/Friday", time.Date(2019, 12, 6, 19, 0, 0, 0, tz), true},
+				{"BeforeRange/Monday", time.Date(2019, 12, 2, 7, 0, 0, 0, tz), false},
+				{"AfterRange/Friday", time.Date(2019, 12, 6, 21, 0, 0, 0, tz), false},
+				{"OutOfDays/Saturday", time.Date(2019, 12, 7, 9, 0, 0, 0, tz), false},
+				{"OutOfDays/Sunday", time.Date(2019, 12, 8, 9, 0, 0, 0, tz), false},
+			} {
+				t.Run(c.name, func(t *testing.T) {
+					got := weekly.IsActive(c.now)
+					if got != c.want {
+						t.Errorf("IsActive wants %v but %v (weekly=%+v)", c.want, got, weekly)
+					}
+				})
+			}
+		})
+		t.Run("EndTime<StartTime", func(t *testing.T) {
+			// Friday 23:00:00 to Saturday 01:00:00
+			weekly, err := schedule.NewWeeklyRange([]string{"Friday"}, "23:00:00", "01:00:00")
+			if err != nil {
+				t.Fatalf("NewWeeklyRange error: %s", err)
+			}
+			for _, c := range []struct {
+				name string
+				now  time.Time
+				want bool
+			}{
+				{"BeforeStart/Friday", time.Date(2019, 12, 6, 22, 0, 0, 0, tz), false},
+				{"AfterStart/Friday", time.Date(2019, 12, 6, 23, 30, 0, 0, tz), true},
+				{"BeforeEnd/Saturday", time.Date(2019, 12, 7, 0, 30, 0, 0, tz), true},
+				{"AfterEnd/Saturday", time.Date(2019, 12, 7, 1, 30, 0, 0, tz), false},
+				{"OutOfDays/Thursday", time.Date(2019, 12, 5, 23, 30, 0, 0, tz), false},
+				{"OutOfDays/Friday", time.Date(2019, 12, 6, 0, 30, 0, 0, tz), false},
+			} {
+				t.Run(c.name, func(t *testing.T) {
+					got := weekly.IsActive(c.now)
+					if got != c.want {
+						t.Errorf("IsActive wants %v but %v (weekly=%+v)", c.want, got, weekly)
+					}
+				})
+			}
+		})
+	}
+
+	for _, tz := range timezones {
+		t.Run(tz.String(), func(t *testing.T) {
+			tests(t, tz)
+		})
+	}
+}
+
+func TestWeeklyRange_NextEdge(t *testing.T) {
+	tests := func(t *testing.T, tz *time.Location) {
+		t.Run("StartTime<EndTime", func(t *testing.T) {
+			// Monday to Friday, 08:00:00 to 20:00:00
+			weeklyRange, err := schedule.NewWeeklyRange([]string{"Mon", "Tue", "Wed", "Thu", "Fri"}, "08:00:00", "20:00:00")
+			if err != nil {
+				t.Fatalf("NewWeeklyRange error: %s", err)
+			}
+			for _, c := range []struct {
+				name string
+				now  time.Time
+				want time.Time
+			}{
+				{"BeforeStart/Monday", time.Date(2019, 12, 2, 7, 0, 0, 0, tz), time.Date(2019, 12, 2, 8, 0, 0, 0, tz)},
+				{"AfterStartBeforeEnd/Monday", time.Date(2019, 12, 2, 9, 0, 0, 0, tz), time.Date(2019, 12, 2, 20, 0, 0, 0, tz)},
+				{"AfterEnd/Monday", time.Date(2019, 12, 2, 21, 0, 0, 0, tz), time.Date(2019, 12, 3, 8, 0, 0, 0, tz)},
+				{"AfterEnd/Friday", time.Date(2019, 12, 6, 21, 0, 0, 0, tz), time.Date(2019, 12, 9, 8, 0, 0, 0, tz)},
+				{"OutOfDays/Sunday", time.Date(2019, 12, 8, 9, 0, 0, 0, tz), time.Date(2019, 12, 9, 8, 0, 0, 0, tz)},
+			} {
+				t.Run(c.name, func(t *testing.T) {
+					got := weeklyRange.NextEdge(c.now)
+					if diff := cmp.Diff(c.want, got); diff != "" {
+						t.Errorf("mismatch (-want +got):\n%s", diff)
+					}
+				})
+			}
+		})
+		t.Run("EndTime<StartTime", func(t *testing.T) {
+			// Friday 23:00:00 to Saturday 01:00:00
+			weeklyRange, err := schedule.NewWeeklyRange([]string{"Friday"}, "23:00:00", "01:00:00")
+			if err != nil {
+				t.Fatalf("NewWeeklyRange error: %s", err)
+			}
+			for _, c := range []struct {
+				name string
+				now  time.Time
+				want time.Time
+			}{
+				{"BeforeStart/Friday", time.Date(2019, 12, 6, 22, 0, 0, 0, tz), time.Date(2019, 12, 6, 23, 0, 0, 0, tz)},
+				{"AfterStart/Friday", time.Date(2019, 12, 6, 23, 30, 0, 0, tz), time.Date(2019, 12, 7, 1, 0, 0, 0, tz)},
+				{"BeforeEnd/Saturday", time.Date(2019, 12, 7, 0, 30, 0, 0, tz), time.Date(2019, 12, 7, 1, 0, 0, 0, tz)},
+				{"AfterEnd/Saturday", time.Date(2019, 12, 7, 1, 30, 0, 0, tz), time.Date(2019, 12, 13, 23, 0, 0, 0, tz)},
+				{"AfterStart/Wednesday", time.Date(2019, 12, 4, 23, 30, 0, 0, tz), time.Date(2019, 12, 6, 23, 0, 0, 0, tz)},
+			} {
+				t.Run(c.name, func(t *testing.T) {
+					got := weeklyRange.NextEdge(c.now)
+					if diff := cmp.Diff(c.want, got); diff != "" {
+						t.Errorf("mismatch (-want +got):\n%s", diff)
+					}
+				})
+			}
+		})
+	}
+
+	for _, tz := range timezones {
+		t.Run(tz.String(), func(t *testing.T) {
+			tests(t, tz)
+		})
+	}
+}
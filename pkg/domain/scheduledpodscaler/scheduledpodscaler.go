@@ -1,10 +1,17 @@
 package scheduledpodscaler
 
 import (
+	"fmt"
+	"math"
+	"strconv"
 	"time"
 
 	"github.com/int128/scheduled-scaler/pkg/domain/schedule"
+	"golang.org/x/xerrors"
+	kcore "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 type ScheduledPodScaler struct {
@@ -19,24 +26,165 @@ type Spec struct {
 	ScaleTarget      ScaleTarget
 	ScaleRules       []ScaleRule
 	DefaultScaleSpec ScaleSpec
+	DefaultMode      DefaultMode    // DefaultModeSpec if not set
+	ConflictPolicy   ConflictPolicy // ConflictPolicyFirst if not set
+	DryRun           bool
+	Suspend          bool
+	SuspendUntil     time.Time   // zero if suspended until resumed manually
+	RampUp           *RampPolicy // nil if not ramping
+	RampDown         *RampPolicy // nil if not ramping
+	ScaleDownDelay   time.Duration
+}
+
+// DefaultMode represents how to scale the targets when no rule is active.
+type DefaultMode string
+
+const (
+	// DefaultModeSpec scales the targets to DefaultScaleSpec.
+	DefaultModeSpec DefaultMode = "spec"
+	// DefaultModeRestore restores the targets to the original replicas.
+	DefaultModeRestore DefaultMode = "restore"
+)
+
+// IsSuspended returns true if the scaling is suspended at the time.
+// SuspendUntil is effective only while Suspend is true.
+func (s *Spec) IsSuspended(now time.Time) bool {
+	if !s.Suspend {
+		return false
+	}
+	return s.SuspendUntil.IsZero() || now.Before(s.SuspendUntil)
+}
+
+// ConflictPolicy represents how to choose a rule when multiple rules are active.
+type ConflictPolicy string
+
+const (
+	// ConflictPolicyFirst chooses the first active rule.
+	ConflictPolicyFirst ConflictPolicy = "first"
+	// ConflictPolicyHighestPriority chooses the active rule of the highest priority.
+	ConflictPolicyHighestPriority ConflictPolicy = "highestPriority"
+	// ConflictPolicyMaxReplicas chooses the active rule of the most replicas.
+	ConflictPolicyMaxReplicas ConflictPolicy = "maxReplicas"
+	// ConflictPolicyMinReplicas chooses the active rule of the fewest replicas.
+	ConflictPolicyMinReplicas ConflictPolicy = "minReplicas"
+)
+
+// prefers returns true if the rule a should be chosen rather than b.
+// It returns false if they are tied.
+func (p ConflictPolicy) prefers(a, b ScaleRule) bool {
+	switch p {
+	case ConflictPolicyHighestPriority:
+		return a.Priority > b.Priority
+	case ConflictPolicyMaxReplicas:
+		return a.ScaleSpec.size() > b.ScaleSpec.size()
+	case ConflictPolicyMinReplicas:
+		return a.ScaleSpec.size() < b.ScaleSpec.size()
+	}
+	return false
 }
 
 // ComputeDesiredScaleSpec returns the ScaleSpec corresponding to the current time.
-// This finds the active ScaleRule in order.
+// This finds the active ScaleRule by the ConflictPolicy.
 func (s *Spec) ComputeDesiredScaleSpec(now time.Time) ScaleSpec {
-	for _, rule := range s.ScaleRules {
-		if rule.IsActive(now) {
-			return rule.ScaleSpec
+	i := s.FindActiveRuleIndex(now)
+	if i < 0 {
+		return s.DefaultScaleSpec
+	}
+	return s.ScaleRules[i].ScaleSpec
+}
+
+// FindActiveRuleIndex returns the index of the active ScaleRule.
+// If multiple rules are active, it chooses one by the ConflictPolicy, or the first one if they are tied.
+// It returns -1 if no ScaleRule is active, i.e. the DefaultScaleSpec is applied.
+func (s *Spec) FindActiveRuleIndex(now time.Time) int {
+	found := -1
+	for i, rule := range s.ScaleRules {
+		if !rule.IsActive(now) {
+			continue
+		}
+		if found < 0 || s.ConflictPolicy.prefers(rule, s.ScaleRules[found]) {
+			found = i
 		}
 	}
-	return s.DefaultScaleSpec
+	return found
+}
+
+// IgnoreAnnotation is the annotation to exclude a target from scaling.
+const IgnoreAnnotation = "scheduledscaling.int128.github.io/ignore"
+
+// IsIgnored returns true if the annotations of a target have IgnoreAnnotation of "true".
+func IsIgnored(annotations map[string]string) bool {
+	return annotations[IgnoreAnnotation] == "true"
+}
+
+// BaselineReplicasAnnotation is the annotation of a target to compute the replicas by a percentage.
+const BaselineReplicasAnnotation = "scheduledscaling.int128.github.io/baseline-replicas"
+
+// FindBaselineReplicas returns the value of BaselineReplicasAnnotation in the annotations.
+// It returns false if the annotation is not set.
+func FindBaselineReplicas(annotations map[string]string) (int32, bool, error) {
+	return findReplicasAnnotation(annotations, BaselineReplicasAnnotation)
+}
+
+// OriginalReplicasAnnotation is the annotation of a target to restore the replicas when no rule is active.
+const OriginalReplicasAnnotation = "scheduledscaling.int128.github.io/original-replicas"
+
+// FindOriginalReplicas returns the value of OriginalReplicasAnnotation in the annotations.
+// It returns false if the annotation is not set.
+func FindOriginalReplicas(annotations map[string]string) (int32, bool, error) {
+	return findReplicasAnnotation(annotations, OriginalReplicasAnnotation)
+}
+
+// findReplicasAnnotation returns the replicas in the annotation.
+// It returns an error which implements errors.Invalid if the value is not valid.
+func findReplicasAnnotation(annotations map[string]string, key string) (int32, bool, error) {
+	v, ok := annotations[key]
+	if !ok {
+		return 0, false, nil
+	}
+	replicas, err := strconv.ParseInt(v, 10, 32)
+	if err != nil {
+		return 0, false, &invalidAnnotationError{xerrors.Errorf("invalid annotation %s: %w", key, err)}
+	}
+	if replicas < 0 {
+		return 0, false, &invalidAnnotationError{xerrors.Errorf("invalid annotation %s: must not be negative but was %d", key, replicas)}
+	}
+	return int32(replicas), true, nil
+}
+
+type invalidAnnotationError struct {
+	error
+}
+
+func (err *invalidAnnotationError) IsInvalid() bool {
+	return true
+}
+
+// DefaultRuleName is the name of DefaultScaleSpec in the status.
+const DefaultRuleName = "default"
+
+// RuleName returns the name of the ScaleRule at the index.
+// It returns the index such as schedule[0] if the ScaleRule has no name.
+// It returns DefaultRuleName if the index is negative.
+func (s *Spec) RuleName(index int) string {
+	if index < 0 {
+		return DefaultRuleName
+	}
+	if s.ScaleRules[index].Name != "" {
+		return s.ScaleRules[index].Name
+	}
+	return fmt.Sprintf("schedule[%d]", index)
 }
 
 // FindNextReconcileTime returns the next time to reconcile.
 // This finds the earliest ScaleRule in order.
+// It returns zero if no ScaleRule will start or end, e.g. all rules have expired.
 func (s *Spec) FindNextReconcileTime(now time.Time) (earliest time.Time) {
 	for _, rule := range s.ScaleRules {
 		edge := rule.NextEdge(now)
+		if edge.IsZero() {
+			continue
+		}
 		if earliest.IsZero() || edge.Before(earliest) {
 			earliest = edge
 		}
@@ -45,13 +193,109 @@ func (s *Spec) FindNextReconcileTime(now time.Time) (earliest time.Time) {
 }
 
 type ScaleTarget struct {
-	Selectors map[string]string
+	GroupVersionKind  schema.GroupVersionKind
+	Name              string // if set, Selector is not used
+	Selector          labels.Selector
+	NamespaceSelector labels.Selector // nil means the namespace of the ScheduledPodScaler
+}
+
+// IsHorizontalPodAutoscaler returns true if the target is HorizontalPodAutoscaler.
+func (t *ScaleTarget) IsHorizontalPodAutoscaler() bool {
+	return t.GroupVersionKind.GroupKind() == schema.GroupKind{Group: "autoscaling", Kind: "HorizontalPodAutoscaler"}
+}
+
+// FindRamp returns the ramp policies of the rule at the index.
+// It falls back to the policies of the spec if the rule has no policy or the index is negative.
+func (s *Spec) FindRamp(index int) Ramp {
+	ramp := Ramp{Up: s.RampUp, Down: s.RampDown}
+	if index < 0 {
+		return ramp
+	}
+	rule := s.ScaleRules[index]
+	if rule.RampUp != nil {
+		ramp.Up = rule.RampUp
+	}
+	if rule.RampDown != nil {
+		ramp.Down = rule.RampDown
+	}
+	return ramp
+}
+
+// Behavior represents how to scale the targets by a rule.
+type Behavior struct {
+	Mode           ScaleMode
+	Ramp           Ramp
+	ScaleDownDelay time.Duration
+	// RecordOriginal is true if the original replicas of the targets should be recorded before scaling.
+	RecordOriginal bool
+	// RestoreOriginal is true if the targets should be scaled to the original replicas.
+	RestoreOriginal bool
+}
+
+// FindBehavior returns the behavior of the rule at the index.
+// It falls back to the spec or the default if the rule has no setting or the index is negative.
+func (s *Spec) FindBehavior(index int) Behavior {
+	mode := ScaleModeExact
+	if index >= 0 && s.ScaleRules[index].Mode != "" {
+		mode = s.ScaleRules[index].Mode
+	}
+	restore := s.DefaultMode == DefaultModeRestore
+	return Behavior{
+		Mode:            mode,
+		Ramp:            s.FindRamp(index),
+		ScaleDownDelay:  s.FindScaleDownDelay(index),
+		RecordOriginal:  restore && index >= 0,
+		RestoreOriginal: restore && index < 0,
+	}
+}
+
+// FindScaleDownDelay returns the delay of scale-down of the rule at the index.
+// It falls back to the delay of the spec if the rule has no delay or the index is negative.
+func (s *Spec) FindScaleDownDelay(index int) time.Duration {
+	if index >= 0 && s.ScaleRules[index].ScaleDownDelay > 0 {
+		return s.ScaleRules[index].ScaleDownDelay
+	}
+	return s.ScaleDownDelay
 }
 
 type ScaleRule struct {
+	Name      string // empty if not set
 	Range     schedule.Range
 	Timezone  *time.Location // must be non-nil
 	ScaleSpec ScaleSpec
+	RampUp    *RampPolicy // nil if not ramping
+	RampDown  *RampPolicy // nil if not ramping
+	// ScaleDownDelay overrides the ScaleDownDelay of the spec if positive.
+	ScaleDownDelay time.Duration
+	Mode           ScaleMode // ScaleModeExact if not set
+	Priority       int32     // used by ConflictPolicyHighestPriority
+}
+
+// ScaleMode represents how to apply the desired replicas to the current replicas.
+type ScaleMode string
+
+const (
+	// ScaleModeExact changes the replicas to the desired replicas.
+	ScaleModeExact ScaleMode = "exact"
+	// ScaleModeAtLeast increases the replicas to the desired replicas but does not decrease.
+	ScaleModeAtLeast ScaleMode = "atLeast"
+	// ScaleModeAtMost decreases the replicas to the desired replicas but does not increase.
+	ScaleModeAtMost ScaleMode = "atMost"
+)
+
+// Apply returns the replicas to apply to the target in the mode.
+func (m ScaleMode) Apply(current, desired int32) int32 {
+	switch m {
+	case ScaleModeAtLeast:
+		if current > desired {
+			return current
+		}
+	case ScaleModeAtMost:
+		if current < desired {
+			return current
+		}
+	}
+	return desired
 }
 
 func (r *ScaleRule) IsActive(now time.Time) bool {
@@ -63,9 +307,279 @@ func (r *ScaleRule) NextEdge(now time.Time) time.Time {
 }
 
 type ScaleSpec struct {
-	Replicas int32
+	Replicas    int32
+	MinReplicas *int32      // for HorizontalPodAutoscaler
+	MaxReplicas *int32      // for HorizontalPodAutoscaler
+	Percentage  *Percentage // nil if Replicas is used
+}
+
+// ComputeReplicas returns the desired replicas of a target.
+// If Percentage is set, it computes the replicas from the baseline replicas of the target.
+func (s ScaleSpec) ComputeReplicas(baseline int32) int32 {
+	if s.Percentage == nil {
+		return s.Replicas
+	}
+	return s.Percentage.Compute(baseline)
+}
+
+// size returns the replicas or percentage to compare by a ConflictPolicy.
+func (s ScaleSpec) size() int32 {
+	if s.Percentage != nil {
+		return s.Percentage.Value
+	}
+	return s.Replicas
+}
+
+// Rounding represents how to round the replicas computed by a percentage.
+type Rounding string
+
+const (
+	RoundingUp      Rounding = "up"
+	RoundingDown    Rounding = "down"
+	RoundingNearest Rounding = "nearest"
+)
+
+// Percentage represents the replicas relative to the baseline replicas.
+type Percentage struct {
+	Value    int32    // must not be negative
+	Rounding Rounding // RoundingUp if not set
+	Min      int32    // must not be negative
+}
+
+// Compute returns the replicas of the percentage of the baseline.
+// It returns Min if the computed replicas is less than Min.
+func (p Percentage) Compute(baseline int32) int32 {
+	x := int64(baseline) * int64(p.Value)
+	var replicas int64
+	switch p.Rounding {
+	case RoundingDown:
+		replicas = x / 100
+	case RoundingNearest:
+		replicas = (x + 50) / 100
+	default:
+		replicas = (x + 99) / 100
+	}
+	if replicas < int64(p.Min) {
+		return p.Min
+	}
+	if replicas > math.MaxInt32 {
+		return math.MaxInt32
+	}
+	return int32(replicas)
+}
+
+// RampPolicy represents a policy to change the replicas step by step.
+type RampPolicy struct {
+	MaxStep  int32         // must be positive
+	Interval time.Duration // must be positive
+}
+
+// Ramp represents the policies to increase and decrease the replicas.
+type Ramp struct {
+	Up   *RampPolicy // nil if the replicas is increased at once
+	Down *RampPolicy // nil if the replicas is decreased at once
+}
+
+// ComputeStep returns the replicas to apply now and the time of the next step.
+// If the target has been scaled within the interval, it returns the current replicas to wait for the next step.
+// The next step time is zero if the replicas reaches the desired replicas.
+func (r Ramp) ComputeStep(current, desired int32, lastScaleTime, now time.Time) (int32, time.Time) {
+	policy := r.Up
+	if desired < current {
+		policy = r.Down
+	}
+	if policy == nil || current == desired {
+		return desired, time.Time{}
+	}
+	if !lastScaleTime.IsZero() && now.Before(lastScaleTime.Add(policy.Interval)) {
+		return current, lastScaleTime.Add(policy.Interval)
+	}
+	replicas := desired
+	if desired-current > policy.MaxStep {
+		replicas = current + policy.MaxStep
+	}
+	if current-desired > policy.MaxStep {
+		replicas = current - policy.MaxStep
+	}
+	if replicas == desired {
+		return desired, time.Time{}
+	}
+	return replicas, now.Add(policy.Interval)
+}
+
+// ComputeScaleDownTime returns the time when the target can be scaled down.
+// It returns zero if the desired replicas is not less than the current replicas.
+// It keeps the previous time until the target reaches the desired replicas,
+// so that the delay is applied only once in a scale-down even if it is ramping.
+func ComputeScaleDownTime(current, desired int32, delay time.Duration, previous, now time.Time) time.Time {
+	if desired >= current || delay <= 0 {
+		return time.Time{}
+	}
+	if !previous.IsZero() {
+		return previous
+	}
+	return now.Add(delay)
 }
 
 type Status struct {
 	NextReconcileTime time.Time
+	ActiveRule        string     // name of the active rule or DefaultRuleName
+	DesiredScaleSpec  *ScaleSpec // nil if not computed yet
+	LastScaleTime     time.Time  // zero if never scaled
+	Targets           []TargetStatus
+	Conditions        []Condition
+}
+
+// TargetStatus represents the status of a target found by the ScaleTarget.
+type TargetStatus struct {
+	Namespace     string
+	Name          string
+	Current       ScaleSpec // observed replicas of the target before scaling
+	Desired       ScaleSpec
+	LastScaleTime time.Time // zero if never scaled
+	Error         string    // empty if succeeded
+	Skipped       string    // reason why the target is not scaled, empty if not skipped
+	NextStepTime  time.Time // zero if not ramping
+	ScaleDownTime time.Time // zero if not scaling down
+}
+
+// IsHorizontalPodAutoscaler returns true if the target has the observed minReplicas or maxReplicas.
+// It does not depend on the spec, which is not available if it is invalid.
+func (ts TargetStatus) IsHorizontalPodAutoscaler() bool {
+	return ts.Current.MinReplicas != nil || ts.Current.MaxReplicas != nil
+}
+
+// IsScaleNeeded returns true if the observed replicas differ from the desired replicas.
+// A nil field of the desired MinReplicas or MaxReplicas means no change.
+func (ts TargetStatus) IsScaleNeeded() bool {
+	if ts.Current.Replicas != ts.Desired.Replicas {
+		return true
+	}
+	if ts.Current.MinReplicas != nil && ts.Desired.MinReplicas != nil && *ts.Current.MinReplicas != *ts.Desired.MinReplicas {
+		return true
+	}
+	if ts.Current.MaxReplicas != nil && ts.Desired.MaxReplicas != nil && *ts.Current.MaxReplicas != *ts.Desired.MaxReplicas {
+		return true
+	}
+	return false
+}
+
+// FindNextTargetTime returns the earliest time of the next step or delayed scale-down of the targets.
+// It returns zero if no target is ramping or waiting for scale-down.
+func (s *Status) FindNextTargetTime(now time.Time) time.Time {
+	var next time.Time
+	for _, ts := range s.Targets {
+		for _, t := range []time.Time{ts.NextStepTime, ts.ScaleDownTime} {
+			if !t.After(now) {
+				continue
+			}
+			if next.IsZero() || t.Before(next) {
+				next = t
+			}
+		}
+	}
+	return next
+}
+
+// FindTarget returns the status of the target or nil if not found.
+func (s *Status) FindTarget(namespace, name string) *TargetStatus {
+	for i := range s.Targets {
+		if s.Targets[i].Namespace == namespace && s.Targets[i].Name == name {
+			return &s.Targets[i]
+		}
+	}
+	return nil
+}
+
+// SetCondition adds or replaces the condition of the same type.
+// It keeps LastTransitionTime of the existing condition if the status is not changed.
+func (s *Status) SetCondition(c Condition) {
+	for i := range s.Conditions {
+		if s.Conditions[i].Type == c.Type {
+			if s.Conditions[i].Status == c.Status {
+				c.LastTransitionTime = s.Conditions[i].LastTransitionTime
+			}
+			s.Conditions[i] = c
+			return
+		}
+	}
+	s.Conditions = append(s.Conditions, c)
+}
+
+// SetReadyCondition sets the Ready condition by the other conditions.
+// It becomes True if all of ScheduleValid, TargetsFound and Scaled are True and Suspended is not True.
+// Otherwise it becomes False with the reason and message of the first condition which is not expected.
+func (s *Status) SetReadyCondition(now time.Time) {
+	if c := s.FindCondition(ConditionSuspended); c != nil && c.Status == kcore.ConditionTrue {
+		s.SetCondition(Condition{
+			Type:               ConditionReady,
+			Status:             kcore.ConditionFalse,
+			LastTransitionTime: now,
+			Reason:             c.Reason,
+			Message:            c.Message,
+		})
+		return
+	}
+	for _, t := range []ConditionType{ConditionScheduleValid, ConditionTargetsFound, ConditionScaled} {
+		c := s.FindCondition(t)
+		if c == nil {
+			s.SetCondition(Condition{
+				Type:               ConditionReady,
+				Status:             kcore.ConditionFalse,
+				LastTransitionTime: now,
+				Reason:             "Unknown",
+				Message:            fmt.Sprintf("%s is unknown", t),
+			})
+			return
+		}
+		if c.Status != kcore.ConditionTrue {
+			s.SetCondition(Condition{
+				Type:               ConditionReady,
+				Status:             kcore.ConditionFalse,
+				LastTransitionTime: now,
+				Reason:             c.Reason,
+				Message:            c.Message,
+			})
+			return
+		}
+	}
+	s.SetCondition(Condition{
+		Type:               ConditionReady,
+		Status:             kcore.ConditionTrue,
+		LastTransitionTime: now,
+		Reason:             "Ready",
+	})
+}
+
+// FindCondition returns the condition of the type or nil if not found.
+func (s *Status) FindCondition(t ConditionType) *Condition {
+	for i := range s.Conditions {
+		if s.Conditions[i].Type == t {
+			return &s.Conditions[i]
+		}
+	}
+	return nil
+}
+
+type ConditionType string
+
+const (
+	// ConditionReady indicates whether the ScheduledPodScaler works as expected.
+	ConditionReady ConditionType = "Ready"
+	// ConditionScheduleValid indicates whether the spec is valid.
+	ConditionScheduleValid ConditionType = "ScheduleValid"
+	// ConditionTargetsFound indicates whether any target is found.
+	ConditionTargetsFound ConditionType = "TargetsFound"
+	// ConditionScaled indicates whether all targets have the desired replicas.
+	ConditionScaled ConditionType = "Scaled"
+	// ConditionSuspended indicates whether the scaling is suspended.
+	ConditionSuspended ConditionType = "Suspended"
+)
+
+type Condition struct {
+	Type               ConditionType
+	Status             kcore.ConditionStatus
+	LastTransitionTime time.Time
+	Reason             string
+	Message            string
 }
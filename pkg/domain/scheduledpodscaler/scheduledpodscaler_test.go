@@ -0,0 +1,587 @@
+package scheduledpodscaler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/int128/scheduled-scaler/pkg/domain/errors"
+	"github.com/int128/scheduled-scaler/pkg/domain/schedule"
+	kcore "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
+)
+
+func TestStatus_SetCondition(t *testing.T) {
+	t1 := time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC)
+	t2 := time.Date(2019, 12, 1, 16, 0, 0, 0, time.UTC)
+
+	t.Run("Add", func(t *testing.T) {
+		var s Status
+		s.SetCondition(Condition{Type: ConditionTargetsFound, Status: kcore.ConditionTrue, LastTransitionTime: t1})
+		want := []Condition{
+			{Type: ConditionTargetsFound, Status: kcore.ConditionTrue, LastTransitionTime: t1},
+		}
+		if diff := cmp.Diff(want, s.Conditions); diff != "" {
+			t.Errorf("mismatch (-want, +got):\n%s", diff)
+		}
+	})
+	t.Run("KeepTransitionTime", func(t *testing.T) {
+		s := Status{
+			Conditions: []Condition{
+				{Type: ConditionTargetsFound, Status: kcore.ConditionTrue, LastTransitionTime: t1, Message: "found 1"},
+			},
+		}
+		s.SetCondition(Condition{Type: ConditionTargetsFound, Status: kcore.ConditionTrue, LastTransitionTime: t2, Message: "found 2"})
+		want := []Condition{
+			{Type: ConditionTargetsFound, Status: kcore.ConditionTrue, LastTransitionTime: t1, Message: "found 2"},
+		}
+		if diff := cmp.Diff(want, s.Conditions); diff != "" {
+			t.Errorf("mismatch (-want, +got):\n%s", diff)
+		}
+	})
+	t.Run("Transition", func(t *testing.T) {
+		s := Status{
+			Conditions: []Condition{
+				{Type: ConditionTargetsFound, Status: kcore.ConditionTrue, LastTransitionTime: t1},
+			},
+		}
+		s.SetCondition(Condition{Type: ConditionTargetsFound, Status: kcore.ConditionFalse, LastTransitionTime: t2})
+		want := []Condition{
+			{Type: ConditionTargetsFound, Status: kcore.ConditionFalse, LastTransitionTime: t2},
+		}
+		if diff := cmp.Diff(want, s.Conditions); diff != "" {
+			t.Errorf("mismatch (-want, +got):\n%s", diff)
+		}
+	})
+}
+
+func TestStatus_SetReadyCondition(t *testing.T) {
+	now := time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC)
+
+	t.Run("AllTrue", func(t *testing.T) {
+		s := Status{
+			Conditions: []Condition{
+				{Type: ConditionScheduleValid, Status: kcore.ConditionTrue},
+				{Type: ConditionTargetsFound, Status: kcore.ConditionTrue},
+				{Type: ConditionScaled, Status: kcore.ConditionTrue},
+			},
+		}
+		s.SetReadyCondition(now)
+		want := &Condition{Type: ConditionReady, Status: kcore.ConditionTrue, LastTransitionTime: now, Reason: "Ready"}
+		if diff := cmp.Diff(want, s.FindCondition(ConditionReady)); diff != "" {
+			t.Errorf("mismatch (-want, +got):\n%s", diff)
+		}
+	})
+	t.Run("TargetsNotFound", func(t *testing.T) {
+		s := Status{
+			Conditions: []Condition{
+				{Type: ConditionScheduleValid, Status: kcore.ConditionTrue},
+				{Type: ConditionTargetsFound, Status: kcore.ConditionFalse, Reason: "TargetNotFound", Message: "no Deployment found"},
+				{Type: ConditionScaled, Status: kcore.ConditionFalse, Reason: "TargetNotFound", Message: "no target to scale"},
+			},
+		}
+		s.SetReadyCondition(now)
+		want := &Condition{Type: ConditionReady, Status: kcore.ConditionFalse, LastTransitionTime: now, Reason: "TargetNotFound", Message: "no Deployment found"}
+		if diff := cmp.Diff(want, s.FindCondition(ConditionReady)); diff != "" {
+			t.Errorf("mismatch (-want, +got):\n%s", diff)
+		}
+	})
+	t.Run("Unknown", func(t *testing.T) {
+		s := Status{
+			Conditions: []Condition{
+				{Type: ConditionScheduleValid, Status: kcore.ConditionTrue},
+			},
+		}
+		s.SetReadyCondition(now)
+		want := &Condition{Type: ConditionReady, Status: kcore.ConditionFalse, LastTransitionTime: now, Reason: "Unknown", Message: "TargetsFound is unknown"}
+		if diff := cmp.Diff(want, s.FindCondition(ConditionReady)); diff != "" {
+			t.Errorf("mismatch (-want, +got):\n%s", diff)
+		}
+	})
+	t.Run("Suspended", func(t *testing.T) {
+		s := Status{
+			Conditions: []Condition{
+				{Type: ConditionScheduleValid, Status: kcore.ConditionTrue},
+				{Type: ConditionSuspended, Status: kcore.ConditionTrue, Reason: "Suspended", Message: "suspended until spec.suspend is unset"},
+				{Type: ConditionTargetsFound, Status: kcore.ConditionTrue},
+				{Type: ConditionScaled, Status: kcore.ConditionTrue},
+			},
+		}
+		s.SetReadyCondition(now)
+		want := &Condition{Type: ConditionReady, Status: kcore.ConditionFalse, LastTransitionTime: now, Reason: "Suspended", Message: "suspended until spec.suspend is unset"}
+		if diff := cmp.Diff(want, s.FindCondition(ConditionReady)); diff != "" {
+			t.Errorf("mismatch (-want, +got):\n%s", diff)
+		}
+	})
+}
+
+func TestSpec_FindActiveRuleIndex(t *testing.T) {
+	spec := Spec{
+		ScaleRules: []ScaleRule{
+			{
+				Range:     &schedule.DailyRange{StartTime: 9 * time.Hour, EndTime: 18 * time.Hour},
+				Timezone:  time.UTC,
+				ScaleSpec: ScaleSpec{Replicas: 5},
+			},
+			{
+				Range:     &schedule.DailyRange{StartTime: 12 * time.Hour, EndTime: 20 * time.Hour},
+				Timezone:  time.UTC,
+				ScaleSpec: ScaleSpec{Replicas: 3},
+			},
+		},
+		DefaultScaleSpec: ScaleSpec{Replicas: 1},
+	}
+	for _, c := range []struct {
+		now      time.Time
+		index    int
+		name     string
+		replicas int32
+	}{
+		{time.Date(2019, 12, 1, 8, 0, 0, 0, time.UTC), -1, "default", 1},
+		{time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC), 0, "schedule[0]", 5},
+		{time.Date(2019, 12, 1, 19, 0, 0, 0, time.UTC), 1, "schedule[1]", 3},
+	} {
+		t.Run(c.now.String(), func(t *testing.T) {
+			index := spec.FindActiveRuleIndex(c.now)
+			if index != c.index {
+				t.Errorf("index wants %d but %d", c.index, index)
+			}
+			if name := spec.RuleName(index); name != c.name {
+				t.Errorf("name wants %s but %s", c.name, name)
+			}
+			if replicas := spec.ComputeDesiredScaleSpec(c.now).Replicas; replicas != c.replicas {
+				t.Errorf("replicas wants %d but %d", c.replicas, replicas)
+			}
+		})
+	}
+}
+
+func TestSpec_FindActiveRuleIndex_ConflictPolicy(t *testing.T) {
+	weekdays := []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+	weekends := []time.Weekday{time.Saturday, time.Sunday}
+	rules := []ScaleRule{
+		{
+			Range:     &schedule.DailyRange{StartTime: 9 * time.Hour, EndTime: 18 * time.Hour},
+			Timezone:  time.UTC,
+			ScaleSpec: ScaleSpec{Replicas: 5},
+		},
+		{
+			Range:     &schedule.WeeklyRange{Weekdays: weekdays, StartTime: 12 * time.Hour, EndTime: 14 * time.Hour},
+			Timezone:  time.UTC,
+			ScaleSpec: ScaleSpec{Replicas: 10},
+			Priority:  1,
+		},
+		{
+			Range:     &schedule.WeeklyRange{Weekdays: weekends, StartTime: 8 * time.Hour, EndTime: 20 * time.Hour},
+			Timezone:  time.UTC,
+			ScaleSpec: ScaleSpec{Replicas: 2},
+			Priority:  3,
+		},
+		{
+			Range:     &schedule.DailyRange{StartTime: 17 * time.Hour, EndTime: 22 * time.Hour},
+			Timezone:  time.UTC,
+			ScaleSpec: ScaleSpec{Replicas: 3},
+			Priority:  2,
+		},
+	}
+	// 2019-12-01 is Sunday and 2019-12-02 is Monday
+	for name, c := range map[string]struct {
+		now  time.Time
+		want map[ConflictPolicy]int
+	}{
+		"NoRule": {
+			time.Date(2019, 12, 2, 8, 0, 0, 0, time.UTC),
+			map[ConflictPolicy]int{"": -1, ConflictPolicyFirst: -1, ConflictPolicyHighestPriority: -1, ConflictPolicyMaxReplicas: -1, ConflictPolicyMinReplicas: -1},
+		},
+		"DailyOnly": {
+			time.Date(2019, 12, 2, 10, 0, 0, 0, time.UTC),
+			map[ConflictPolicy]int{"": 0, ConflictPolicyFirst: 0, ConflictPolicyHighestPriority: 0, ConflictPolicyMaxReplicas: 0, ConflictPolicyMinReplicas: 0},
+		},
+		"DailyAndWeekday": {
+			time.Date(2019, 12, 2, 13, 0, 0, 0, time.UTC),
+			map[ConflictPolicy]int{"": 0, ConflictPolicyFirst: 0, ConflictPolicyHighestPriority: 1, ConflictPolicyMaxReplicas: 1, ConflictPolicyMinReplicas: 0},
+		},
+		"DailyAndEvening": {
+			time.Date(2019, 12, 2, 17, 30, 0, 0, time.UTC),
+			map[ConflictPolicy]int{"": 0, ConflictPolicyFirst: 0, ConflictPolicyHighestPriority: 3, ConflictPolicyMaxReplicas: 0, ConflictPolicyMinReplicas: 3},
+		},
+		"EveningOnly": {
+			time.Date(2019, 12, 2, 20, 0, 0, 0, time.UTC),
+			map[ConflictPolicy]int{"": 3, ConflictPolicyFirst: 3, ConflictPolicyHighestPriority: 3, ConflictPolicyMaxReplicas: 3, ConflictPolicyMinReplicas: 3},
+		},
+		"DailyAndWeekend": {
+			time.Date(2019, 12, 1, 13, 0, 0, 0, time.UTC),
+			map[ConflictPolicy]int{"": 0, ConflictPolicyFirst: 0, ConflictPolicyHighestPriority: 2, ConflictPolicyMaxReplicas: 0, ConflictPolicyMinReplicas: 2},
+		},
+		"DailyAndWeekendAndEvening": {
+			time.Date(2019, 12, 1, 17, 30, 0, 0, time.UTC),
+			map[ConflictPolicy]int{"": 0, ConflictPolicyFirst: 0, ConflictPolicyHighestPriority: 2, ConflictPolicyMaxReplicas: 0, ConflictPolicyMinReplicas: 2},
+		},
+		"WeekendAndEvening": {
+			time.Date(2019, 12, 1, 19, 0, 0, 0, time.UTC),
+			map[ConflictPolicy]int{"": 2, ConflictPolicyFirst: 2, ConflictPolicyHighestPriority: 2, ConflictPolicyMaxReplicas: 3, ConflictPolicyMinReplicas: 2},
+		},
+	} {
+		for policy, want := range c.want {
+			t.Run(name+"/"+string(policy), func(t *testing.T) {
+				spec := Spec{ScaleRules: rules, ConflictPolicy: policy}
+				if index := spec.FindActiveRuleIndex(c.now); index != want {
+					t.Errorf("index wants %d but %d", want, index)
+				}
+			})
+		}
+	}
+
+	t.Run("Tied", func(t *testing.T) {
+		tiedRules := []ScaleRule{
+			{
+				Range:     &schedule.DailyRange{StartTime: 9 * time.Hour, EndTime: 18 * time.Hour},
+				Timezone:  time.UTC,
+				ScaleSpec: ScaleSpec{Replicas: 5},
+				Priority:  1,
+			},
+			{
+				Range:     &schedule.WeeklyRange{Weekdays: weekdays, StartTime: 9 * time.Hour, EndTime: 18 * time.Hour},
+				Timezone:  time.UTC,
+				ScaleSpec: ScaleSpec{Replicas: 5},
+				Priority:  1,
+			},
+		}
+		for _, policy := range []ConflictPolicy{ConflictPolicyFirst, ConflictPolicyHighestPriority, ConflictPolicyMaxReplicas, ConflictPolicyMinReplicas} {
+			spec := Spec{ScaleRules: tiedRules, ConflictPolicy: policy}
+			if index := spec.FindActiveRuleIndex(time.Date(2019, 12, 2, 12, 0, 0, 0, time.UTC)); index != 0 {
+				t.Errorf("index wants 0 by %s but %d", policy, index)
+			}
+		}
+	})
+
+	t.Run("Percentage", func(t *testing.T) {
+		spec := Spec{
+			ScaleRules: []ScaleRule{
+				{
+					Range:     &schedule.DailyRange{StartTime: 9 * time.Hour, EndTime: 18 * time.Hour},
+					Timezone:  time.UTC,
+					ScaleSpec: ScaleSpec{Percentage: &Percentage{Value: 50}},
+				},
+				{
+					Range:     &schedule.DailyRange{StartTime: 12 * time.Hour, EndTime: 20 * time.Hour},
+					Timezone:  time.UTC,
+					ScaleSpec: ScaleSpec{Percentage: &Percentage{Value: 100}},
+				},
+			},
+			ConflictPolicy: ConflictPolicyMaxReplicas,
+		}
+		if index := spec.FindActiveRuleIndex(time.Date(2019, 12, 2, 13, 0, 0, 0, time.UTC)); index != 1 {
+			t.Errorf("index wants 1 but %d", index)
+		}
+	})
+}
+
+func TestSpec_RuleName(t *testing.T) {
+	spec := Spec{
+		ScaleRules: []ScaleRule{
+			{Name: "daytime"},
+			{},
+		},
+	}
+	for _, c := range []struct {
+		index int
+		want  string
+	}{
+		{-1, "default"},
+		{0, "daytime"},
+		{1, "schedule[1]"},
+	} {
+		t.Run(c.want, func(t *testing.T) {
+			if got := spec.RuleName(c.index); got != c.want {
+				t.Errorf("name wants %s but %s", c.want, got)
+			}
+		})
+	}
+}
+
+func TestTargetStatus_IsScaleNeeded(t *testing.T) {
+	for name, c := range map[string]struct {
+		ts   TargetStatus
+		want bool
+	}{
+		"ReplicasChanged": {
+			TargetStatus{Current: ScaleSpec{Replicas: 3}, Desired: ScaleSpec{Replicas: 5}},
+			true,
+		},
+		"ReplicasNotChanged": {
+			TargetStatus{Current: ScaleSpec{Replicas: 5}, Desired: ScaleSpec{Replicas: 5}},
+			false,
+		},
+		"MinReplicasChanged": {
+			TargetStatus{
+				Current: ScaleSpec{MinReplicas: pointer.Int32Ptr(1), MaxReplicas: pointer.Int32Ptr(10)},
+				Desired: ScaleSpec{MinReplicas: pointer.Int32Ptr(5)},
+			},
+			true,
+		},
+		"MaxReplicasNotChanged": {
+			TargetStatus{
+				Current: ScaleSpec{MinReplicas: pointer.Int32Ptr(1), MaxReplicas: pointer.Int32Ptr(10)},
+				Desired: ScaleSpec{MaxReplicas: pointer.Int32Ptr(10)},
+			},
+			false,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if got := c.ts.IsScaleNeeded(); got != c.want {
+				t.Errorf("IsScaleNeeded wants %v but %v", c.want, got)
+			}
+		})
+	}
+}
+
+func TestSpec_IsSuspended(t *testing.T) {
+	now := time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC)
+	for name, c := range map[string]struct {
+		spec Spec
+		want bool
+	}{
+		"NotSuspended":            {Spec{}, false},
+		"Suspended":               {Spec{Suspend: true}, true},
+		"SuspendedUntilFuture":    {Spec{Suspend: true, SuspendUntil: now.Add(time.Hour)}, true},
+		"SuspendedUntilPast":      {Spec{Suspend: true, SuspendUntil: now.Add(-time.Hour)}, false},
+		"SuspendUntilWithoutFlag": {Spec{SuspendUntil: now.Add(time.Hour)}, false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if got := c.spec.IsSuspended(now); got != c.want {
+				t.Errorf("IsSuspended wants %v but %v", c.want, got)
+			}
+		})
+	}
+}
+
+func TestSpec_FindRamp(t *testing.T) {
+	specRampUp := &RampPolicy{MaxStep: 1, Interval: time.Minute}
+	ruleRampUp := &RampPolicy{MaxStep: 5, Interval: time.Minute}
+	spec := Spec{
+		ScaleRules: []ScaleRule{
+			{RampUp: ruleRampUp},
+			{},
+		},
+		RampUp: specRampUp,
+	}
+	for _, c := range []struct {
+		index int
+		want  Ramp
+	}{
+		{-1, Ramp{Up: specRampUp}},
+		{0, Ramp{Up: ruleRampUp}},
+		{1, Ramp{Up: specRampUp}},
+	} {
+		t.Run(spec.RuleName(c.index), func(t *testing.T) {
+			got := spec.FindRamp(c.index)
+			if diff := cmp.Diff(c.want, got); diff != "" {
+				t.Errorf("mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRamp_ComputeStep(t *testing.T) {
+	now := time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC)
+	ramp := Ramp{
+		Up:   &RampPolicy{MaxStep: 10, Interval: 5 * time.Minute},
+		Down: &RampPolicy{MaxStep: 5, Interval: 10 * time.Minute},
+	}
+	for name, c := range map[string]struct {
+		ramp          Ramp
+		current       int32
+		desired       int32
+		lastScaleTime time.Time
+		wantReplicas  int32
+		wantNextStep  time.Time
+	}{
+		"NoPolicy":       {Ramp{}, 2, 40, time.Time{}, 40, time.Time{}},
+		"NotChanged":     {ramp, 40, 40, time.Time{}, 40, time.Time{}},
+		"RampUp":         {ramp, 2, 40, time.Time{}, 12, now.Add(5 * time.Minute)},
+		"RampUpLastStep": {ramp, 32, 40, now.Add(-time.Hour), 40, time.Time{}},
+		"RampUpWait":     {ramp, 12, 40, now.Add(-time.Minute), 12, now.Add(4 * time.Minute)},
+		"RampDown":       {ramp, 40, 2, now.Add(-time.Hour), 35, now.Add(10 * time.Minute)},
+		"RampDownWait":   {ramp, 35, 2, now.Add(-time.Minute), 35, now.Add(9 * time.Minute)},
+		"RampDownAtOnce": {Ramp{Up: ramp.Up}, 40, 2, time.Time{}, 2, time.Time{}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			replicas, nextStep := c.ramp.ComputeStep(c.current, c.desired, c.lastScaleTime, now)
+			if replicas != c.wantReplicas {
+				t.Errorf("replicas wants %d but %d", c.wantReplicas, replicas)
+			}
+			if !nextStep.Equal(c.wantNextStep) {
+				t.Errorf("next step wants %s but %s", c.wantNextStep, nextStep)
+			}
+		})
+	}
+}
+
+func TestSpec_FindScaleDownDelay(t *testing.T) {
+	spec := Spec{
+		ScaleRules: []ScaleRule{
+			{ScaleDownDelay: 30 * time.Minute},
+			{},
+		},
+		ScaleDownDelay: 10 * time.Minute,
+	}
+	for _, c := range []struct {
+		index int
+		want  time.Duration
+	}{
+		{-1, 10 * time.Minute},
+		{0, 30 * time.Minute},
+		{1, 10 * time.Minute},
+	} {
+		t.Run(spec.RuleName(c.index), func(t *testing.T) {
+			if got := spec.FindScaleDownDelay(c.index); got != c.want {
+				t.Errorf("delay wants %s but %s", c.want, got)
+			}
+		})
+	}
+}
+
+func TestComputeScaleDownTime(t *testing.T) {
+	now := time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC)
+	for name, c := range map[string]struct {
+		current  int32
+		desired  int32
+		delay    time.Duration
+		previous time.Time
+		want     time.Time
+	}{
+		"ScaleUp":          {1, 5, 10 * time.Minute, time.Time{}, time.Time{}},
+		"NoDelay":          {5, 1, 0, time.Time{}, time.Time{}},
+		"StartDelay":       {5, 1, 10 * time.Minute, time.Time{}, now.Add(10 * time.Minute)},
+		"KeepDelay":        {5, 1, 10 * time.Minute, now.Add(5 * time.Minute), now.Add(5 * time.Minute)},
+		"KeepExpiredDelay": {3, 1, 10 * time.Minute, now.Add(-5 * time.Minute), now.Add(-5 * time.Minute)},
+		"ReachedDesired":   {1, 1, 10 * time.Minute, now.Add(-5 * time.Minute), time.Time{}},
+		"CancelByScaleUp":  {1, 5, 10 * time.Minute, now.Add(5 * time.Minute), time.Time{}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got := ComputeScaleDownTime(c.current, c.desired, c.delay, c.previous, now)
+			if !got.Equal(c.want) {
+				t.Errorf("scale down time wants %s but %s", c.want, got)
+			}
+		})
+	}
+}
+
+func TestScaleMode_Apply(t *testing.T) {
+	for name, c := range map[string]struct {
+		mode    ScaleMode
+		current int32
+		desired int32
+		want    int32
+	}{
+		"ExactUp":       {ScaleModeExact, 2, 10, 10},
+		"ExactDown":     {ScaleModeExact, 10, 2, 2},
+		"AtLeastUp":     {ScaleModeAtLeast, 2, 10, 10},
+		"AtLeastDown":   {ScaleModeAtLeast, 10, 2, 10},
+		"AtMostUp":      {ScaleModeAtMost, 2, 10, 2},
+		"AtMostDown":    {ScaleModeAtMost, 10, 2, 2},
+		"AtMostNoScale": {ScaleModeAtMost, 2, 2, 2},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if got := c.mode.Apply(c.current, c.desired); got != c.want {
+				t.Errorf("replicas wants %d but %d", c.want, got)
+			}
+		})
+	}
+}
+
+func TestSpec_FindBehavior(t *testing.T) {
+	rampUp := &RampPolicy{MaxStep: 1, Interval: time.Minute}
+	spec := Spec{
+		ScaleRules: []ScaleRule{
+			{Mode: ScaleModeAtLeast},
+			{},
+		},
+		RampUp:         rampUp,
+		ScaleDownDelay: 10 * time.Minute,
+	}
+	for _, c := range []struct {
+		index int
+		want  Behavior
+	}{
+		{-1, Behavior{Mode: ScaleModeExact, Ramp: Ramp{Up: rampUp}, ScaleDownDelay: 10 * time.Minute}},
+		{0, Behavior{Mode: ScaleModeAtLeast, Ramp: Ramp{Up: rampUp}, ScaleDownDelay: 10 * time.Minute}},
+		{1, Behavior{Mode: ScaleModeExact, Ramp: Ramp{Up: rampUp}, ScaleDownDelay: 10 * time.Minute}},
+	} {
+		t.Run(spec.RuleName(c.index), func(t *testing.T) {
+			got := spec.FindBehavior(c.index)
+			if diff := cmp.Diff(c.want, got); diff != "" {
+				t.Errorf("mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("DefaultModeRestore", func(t *testing.T) {
+		spec := Spec{
+			ScaleRules:  []ScaleRule{{}},
+			DefaultMode: DefaultModeRestore,
+		}
+		if b := spec.FindBehavior(0); !b.RecordOriginal || b.RestoreOriginal {
+			t.Errorf("rule wants to record the original replicas but %+v", b)
+		}
+		if b := spec.FindBehavior(-1); b.RecordOriginal || !b.RestoreOriginal {
+			t.Errorf("default wants to restore the original replicas but %+v", b)
+		}
+	})
+}
+
+func TestPercentage_Compute(t *testing.T) {
+	for name, c := range map[string]struct {
+		percentage Percentage
+		baseline   int32
+		want       int32
+	}{
+		"Exact":        {Percentage{Value: 25}, 40, 10},
+		"RoundUp":      {Percentage{Value: 25, Rounding: RoundingUp}, 7, 2},
+		"RoundDown":    {Percentage{Value: 25, Rounding: RoundingDown}, 7, 1},
+		"RoundNearest": {Percentage{Value: 25, Rounding: RoundingNearest}, 5, 1},
+		"RoundHalf":    {Percentage{Value: 25, Rounding: RoundingNearest}, 6, 2},
+		"Min":          {Percentage{Value: 25, Rounding: RoundingDown, Min: 2}, 3, 2},
+		"Zero":         {Percentage{Value: 0}, 40, 0},
+		"OverHundred":  {Percentage{Value: 150}, 4, 6},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if got := c.percentage.Compute(c.baseline); got != c.want {
+				t.Errorf("replicas wants %d but %d", c.want, got)
+			}
+		})
+	}
+}
+
+func TestFindBaselineReplicas(t *testing.T) {
+	t.Run("Found", func(t *testing.T) {
+		replicas, ok, err := FindBaselineReplicas(map[string]string{BaselineReplicasAnnotation: "40"})
+		if err != nil {
+			t.Fatalf("FindBaselineReplicas error: %+v", err)
+		}
+		if !ok || replicas != 40 {
+			t.Errorf("FindBaselineReplicas wants (40, true) but (%d, %v)", replicas, ok)
+		}
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		_, ok, err := FindBaselineReplicas(nil)
+		if err != nil {
+			t.Fatalf("FindBaselineReplicas error: %+v", err)
+		}
+		if ok {
+			t.Errorf("FindBaselineReplicas wants not found")
+		}
+	})
+	for _, v := range []string{"foo", "-1"} {
+		t.Run("Invalid/"+v, func(t *testing.T) {
+			_, _, err := FindBaselineReplicas(map[string]string{BaselineReplicasAnnotation: v})
+			if err == nil {
+				t.Fatalf("FindBaselineReplicas wants error but nil")
+			}
+			if !errors.IsInvalid(err) {
+				t.Errorf("error wants invalid but not: %+v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package workload
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Workload represents a resource which has the scale subresource,
+// such as Deployment, StatefulSet or ReplicaSet.
+type Workload struct {
+	TypeMeta   metav1.TypeMeta
+	ObjectMeta metav1.ObjectMeta
+
+	// Replicas is the desired replicas in the scale subresource.
+	Replicas int32
+}
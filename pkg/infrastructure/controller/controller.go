@@ -7,6 +7,7 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/google/wire"
 	"github.com/int128/scheduled-scaler/pkg/domain/errors"
+	"github.com/int128/scheduled-scaler/pkg/infrastructure/metrics"
 	"github.com/int128/scheduled-scaler/pkg/usecases/reconcile"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
@@ -23,6 +24,7 @@ type Interface interface {
 type Controller struct {
 	Log     logr.Logger
 	UseCase reconcile.Interface
+	Metrics metrics.Interface
 }
 
 func (c *Controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -34,9 +36,15 @@ func (c *Controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	if err != nil {
 		if errors.IsTemporary(err) {
 			c.Log.Info("retry reconciliation due to the temporary error", "error", err)
+			c.Metrics.IncReconcileError(metrics.TemporaryError)
 			return ctrl.Result{}, err
 		}
 		c.Log.Error(err, "permanent error")
+		c.Metrics.IncReconcileError(metrics.PermanentError)
+	}
+	if output == nil {
+		c.Log.Info("finished reconciliation without requeue")
+		return ctrl.Result{}, nil
 	}
 	if output.NextReconcileAfter != 0 {
 		c.Log.Info(fmt.Sprintf("finished reconciliation and requeue after %s", output.NextReconcileAfter))
@@ -2,6 +2,7 @@ package errors
 
 import (
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 )
 
 type kubernetesAPIError struct {
@@ -24,3 +25,24 @@ func (err *kubernetesAPIError) IsNotFound() bool {
 func Wrap(err error) error {
 	return &kubernetesAPIError{error: err}
 }
+
+type restMappingError struct {
+	error
+}
+
+func (err *restMappingError) IsTemporary() bool {
+	return !meta.IsNoMatchError(err.error)
+}
+
+func (err *restMappingError) IsUnknownKind() bool {
+	return meta.IsNoMatchError(err.error)
+}
+
+// WrapRESTMapping converts the error of a RESTMapper to an error which implements the following interfaces:
+//
+//	- domain/errors.UnknownKind
+//	- domain/errors.Temporary
+//
+func WrapRESTMapping(err error) error {
+	return &restMappingError{error: err}
+}
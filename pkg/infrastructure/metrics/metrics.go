@@ -0,0 +1,173 @@
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/xerrors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Interface provides the metrics of the controller.
+type Interface interface {
+	ObserveReplicas(scheduledPodScaler types.NamespacedName, target types.NamespacedName, current, desired int32)
+	IncScaleOperation(scheduledPodScaler types.NamespacedName, result ScaleResult)
+	IncReconcileError(errorType ErrorType)
+	ObserveNextReconcile(scheduledPodScaler types.NamespacedName, d time.Duration)
+	DeleteTarget(scheduledPodScaler types.NamespacedName, target types.NamespacedName)
+	DeleteScheduledPodScaler(scheduledPodScaler types.NamespacedName)
+}
+
+type ScaleResult string
+
+const (
+	ScaleSucceeded = ScaleResult("success")
+	ScaleFailed    = ScaleResult("failure")
+)
+
+type ErrorType string
+
+const (
+	TemporaryError = ErrorType("temporary")
+	PermanentError = ErrorType("permanent")
+)
+
+const namespace = "scheduled_scaler"
+
+// Metrics holds the collectors of the controller.
+// Create an instance once and register it to the registry, because a collector cannot be registered twice.
+type Metrics struct {
+	CurrentReplicas      *prometheus.GaugeVec
+	DesiredReplicas      *prometheus.GaugeVec
+	ScaleOperations      *prometheus.CounterVec
+	ReconcileErrors      *prometheus.CounterVec
+	NextReconcileSeconds *prometheus.GaugeVec
+
+	mu      sync.Mutex
+	targets map[types.NamespacedName]map[types.NamespacedName]struct{} // observed targets of each ScheduledPodScaler
+}
+
+// New returns a Metrics with the collectors.
+func New() *Metrics {
+	targetLabels := []string{"namespace", "name", "target_namespace", "target_name"}
+	return &Metrics{
+		CurrentReplicas: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "target_current_replicas",
+			Help:      "Current replicas of the target observed before scaling.",
+		}, targetLabels),
+		DesiredReplicas: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "target_desired_replicas",
+			Help:      "Desired replicas of the target computed by the schedule.",
+		}, targetLabels),
+		ScaleOperations: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "scale_operations_total",
+			Help:      "Total number of scale operations by the result.",
+		}, []string{"namespace", "name", "result"}),
+		ReconcileErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "reconcile_errors_total",
+			Help:      "Total number of reconcile errors by the type.",
+		}, []string{"type"}),
+		NextReconcileSeconds: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "next_reconcile_seconds",
+			Help:      "Seconds until a rule starts or ends.",
+		}, []string{"namespace", "name"}),
+		targets: make(map[types.NamespacedName]map[types.NamespacedName]struct{}),
+	}
+}
+
+// Register registers the collectors to the registry.
+func (m *Metrics) Register(r prometheus.Registerer) error {
+	for _, c := range []prometheus.Collector{
+		m.CurrentReplicas,
+		m.DesiredReplicas,
+		m.ScaleOperations,
+		m.ReconcileErrors,
+		m.NextReconcileSeconds,
+	} {
+		if err := r.Register(c); err != nil {
+			return xerrors.Errorf("could not register the collector: %w", err)
+		}
+	}
+	return nil
+}
+
+// ObserveReplicas sets the current and desired replicas of the target.
+func (m *Metrics) ObserveReplicas(scheduledPodScaler types.NamespacedName, target types.NamespacedName, current, desired int32) {
+	labels := prometheus.Labels{
+		"namespace":        scheduledPodScaler.Namespace,
+		"name":             scheduledPodScaler.Name,
+		"target_namespace": target.Namespace,
+		"target_name":      target.Name,
+	}
+	m.CurrentReplicas.With(labels).Set(float64(current))
+	m.DesiredReplicas.With(labels).Set(float64(desired))
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.targets[scheduledPodScaler] == nil {
+		m.targets[scheduledPodScaler] = make(map[types.NamespacedName]struct{})
+	}
+	m.targets[scheduledPodScaler][target] = struct{}{}
+}
+
+// IncScaleOperation increments the number of scale operations.
+func (m *Metrics) IncScaleOperation(scheduledPodScaler types.NamespacedName, result ScaleResult) {
+	m.ScaleOperations.WithLabelValues(scheduledPodScaler.Namespace, scheduledPodScaler.Name, string(result)).Inc()
+}
+
+// IncReconcileError increments the number of reconcile errors.
+func (m *Metrics) IncReconcileError(errorType ErrorType) {
+	m.ReconcileErrors.WithLabelValues(string(errorType)).Inc()
+}
+
+// ObserveNextReconcile sets the duration until the next reconcile.
+// It removes the metric if zero is given, i.e. no rule will start or end.
+func (m *Metrics) ObserveNextReconcile(scheduledPodScaler types.NamespacedName, d time.Duration) {
+	if d == 0 {
+		m.NextReconcileSeconds.DeleteLabelValues(scheduledPodScaler.Namespace, scheduledPodScaler.Name)
+		return
+	}
+	m.NextReconcileSeconds.WithLabelValues(scheduledPodScaler.Namespace, scheduledPodScaler.Name).Set(d.Seconds())
+}
+
+// DeleteTarget removes the replicas metrics of the target.
+func (m *Metrics) DeleteTarget(scheduledPodScaler types.NamespacedName, target types.NamespacedName) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.deleteTarget(scheduledPodScaler, target)
+	delete(m.targets[scheduledPodScaler], target)
+	if len(m.targets[scheduledPodScaler]) == 0 {
+		delete(m.targets, scheduledPodScaler)
+	}
+}
+
+// DeleteScheduledPodScaler removes the metrics of the ScheduledPodScaler and the observed targets.
+func (m *Metrics) DeleteScheduledPodScaler(scheduledPodScaler types.NamespacedName) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for target := range m.targets[scheduledPodScaler] {
+		m.deleteTarget(scheduledPodScaler, target)
+	}
+	delete(m.targets, scheduledPodScaler)
+	for _, result := range []ScaleResult{ScaleSucceeded, ScaleFailed} {
+		m.ScaleOperations.DeleteLabelValues(scheduledPodScaler.Namespace, scheduledPodScaler.Name, string(result))
+	}
+	m.NextReconcileSeconds.DeleteLabelValues(scheduledPodScaler.Namespace, scheduledPodScaler.Name)
+}
+
+func (m *Metrics) deleteTarget(scheduledPodScaler types.NamespacedName, target types.NamespacedName) {
+	labels := prometheus.Labels{
+		"namespace":        scheduledPodScaler.Namespace,
+		"name":             scheduledPodScaler.Name,
+		"target_namespace": target.Namespace,
+		"target_name":      target.Name,
+	}
+	m.CurrentReplicas.Delete(labels)
+	m.DesiredReplicas.Delete(labels)
+}
@@ -0,0 +1,146 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var scheduledPodScalerName = types.NamespacedName{Namespace: "default", Name: "scaler1"}
+
+func newRegisteredMetrics(t *testing.T) (*Metrics, *prometheus.Registry) {
+	registry := prometheus.NewRegistry()
+	m := New()
+	if err := m.Register(registry); err != nil {
+		t.Fatalf("Register error: %+v", err)
+	}
+	return m, registry
+}
+
+func TestMetrics_Register(t *testing.T) {
+	m, registry := newRegisteredMetrics(t)
+	if err := m.Register(registry); err == nil {
+		t.Errorf("Register wants error for the duplicated collectors but nil")
+	}
+}
+
+func TestMetrics_ObserveReplicas(t *testing.T) {
+	m, registry := newRegisteredMetrics(t)
+	m.ObserveReplicas(scheduledPodScalerName, types.NamespacedName{Namespace: "fixture", Name: "server1"}, 1, 3)
+	m.ObserveReplicas(scheduledPodScalerName, types.NamespacedName{Namespace: "fixture", Name: "server1"}, 3, 3)
+	want := `
+# HELP scheduled_scaler_target_current_replicas Current replicas of the target observed before scaling.
+# TYPE scheduled_scaler_target_current_replicas gauge
+scheduled_scaler_target_current_replicas{name="scaler1",namespace="default",target_name="server1",target_namespace="fixture"} 3
+# HELP scheduled_scaler_target_desired_replicas Desired replicas of the target computed by the schedule.
+# TYPE scheduled_scaler_target_desired_replicas gauge
+scheduled_scaler_target_desired_replicas{name="scaler1",namespace="default",target_name="server1",target_namespace="fixture"} 3
+`
+	if err := testutil.GatherAndCompare(registry, strings.NewReader(want),
+		"scheduled_scaler_target_current_replicas", "scheduled_scaler_target_desired_replicas"); err != nil {
+		t.Errorf("GatherAndCompare error: %s", err)
+	}
+}
+
+func TestMetrics_DeleteTarget(t *testing.T) {
+	m, registry := newRegisteredMetrics(t)
+	m.ObserveReplicas(scheduledPodScalerName, types.NamespacedName{Namespace: "fixture", Name: "server1"}, 1, 3)
+	m.ObserveReplicas(scheduledPodScalerName, types.NamespacedName{Namespace: "fixture", Name: "server2"}, 2, 3)
+	m.DeleteTarget(scheduledPodScalerName, types.NamespacedName{Namespace: "fixture", Name: "server1"})
+	want := `
+# HELP scheduled_scaler_target_current_replicas Current replicas of the target observed before scaling.
+# TYPE scheduled_scaler_target_current_replicas gauge
+scheduled_scaler_target_current_replicas{name="scaler1",namespace="default",target_name="server2",target_namespace="fixture"} 2
+# HELP scheduled_scaler_target_desired_replicas Desired replicas of the target computed by the schedule.
+# TYPE scheduled_scaler_target_desired_replicas gauge
+scheduled_scaler_target_desired_replicas{name="scaler1",namespace="default",target_name="server2",target_namespace="fixture"} 3
+`
+	if err := testutil.GatherAndCompare(registry, strings.NewReader(want),
+		"scheduled_scaler_target_current_replicas", "scheduled_scaler_target_desired_replicas"); err != nil {
+		t.Errorf("GatherAndCompare error: %s", err)
+	}
+}
+
+func TestMetrics_DeleteScheduledPodScaler(t *testing.T) {
+	m, registry := newRegisteredMetrics(t)
+	anotherScheduledPodScalerName := types.NamespacedName{Namespace: "default", Name: "scaler2"}
+	m.ObserveReplicas(scheduledPodScalerName, types.NamespacedName{Namespace: "fixture", Name: "server1"}, 1, 3)
+	m.ObserveReplicas(scheduledPodScalerName, types.NamespacedName{Namespace: "fixture", Name: "server2"}, 2, 3)
+	m.ObserveReplicas(anotherScheduledPodScalerName, types.NamespacedName{Namespace: "fixture", Name: "server3"}, 1, 1)
+	m.IncScaleOperation(scheduledPodScalerName, ScaleSucceeded)
+	m.IncScaleOperation(scheduledPodScalerName, ScaleFailed)
+	m.ObserveNextReconcile(scheduledPodScalerName, 90*time.Minute)
+	m.DeleteScheduledPodScaler(scheduledPodScalerName)
+	want := `
+# HELP scheduled_scaler_target_current_replicas Current replicas of the target observed before scaling.
+# TYPE scheduled_scaler_target_current_replicas gauge
+scheduled_scaler_target_current_replicas{name="scaler2",namespace="default",target_name="server3",target_namespace="fixture"} 1
+# HELP scheduled_scaler_target_desired_replicas Desired replicas of the target computed by the schedule.
+# TYPE scheduled_scaler_target_desired_replicas gauge
+scheduled_scaler_target_desired_replicas{name="scaler2",namespace="default",target_name="server3",target_namespace="fixture"} 1
+`
+	if err := testutil.GatherAndCompare(registry, strings.NewReader(want),
+		"scheduled_scaler_target_current_replicas", "scheduled_scaler_target_desired_replicas",
+		"scheduled_scaler_scale_operations_total", "scheduled_scaler_next_reconcile_seconds"); err != nil {
+		t.Errorf("GatherAndCompare error: %s", err)
+	}
+}
+
+func TestMetrics_IncScaleOperation(t *testing.T) {
+	m, registry := newRegisteredMetrics(t)
+	m.IncScaleOperation(scheduledPodScalerName, ScaleSucceeded)
+	m.IncScaleOperation(scheduledPodScalerName, ScaleSucceeded)
+	m.IncScaleOperation(scheduledPodScalerName, ScaleFailed)
+	want := `
+# HELP scheduled_scaler_scale_operations_total Total number of scale operations by the result.
+# TYPE scheduled_scaler_scale_operations_total counter
+scheduled_scaler_scale_operations_total{name="scaler1",namespace="default",result="failure"} 1
+scheduled_scaler_scale_operations_total{name="scaler1",namespace="default",result="success"} 2
+`
+	if err := testutil.GatherAndCompare(registry, strings.NewReader(want), "scheduled_scaler_scale_operations_total"); err != nil {
+		t.Errorf("GatherAndCompare error: %s", err)
+	}
+}
+
+func TestMetrics_IncReconcileError(t *testing.T) {
+	m, registry := newRegisteredMetrics(t)
+	m.IncReconcileError(TemporaryError)
+	m.IncReconcileError(PermanentError)
+	m.IncReconcileError(TemporaryError)
+	want := `
+# HELP scheduled_scaler_reconcile_errors_total Total number of reconcile errors by the type.
+# TYPE scheduled_scaler_reconcile_errors_total counter
+scheduled_scaler_reconcile_errors_total{type="permanent"} 1
+scheduled_scaler_reconcile_errors_total{type="temporary"} 2
+`
+	if err := testutil.GatherAndCompare(registry, strings.NewReader(want), "scheduled_scaler_reconcile_errors_total"); err != nil {
+		t.Errorf("GatherAndCompare error: %s", err)
+	}
+}
+
+func TestMetrics_ObserveNextReconcile(t *testing.T) {
+	t.Run("Set", func(t *testing.T) {
+		m, registry := newRegisteredMetrics(t)
+		m.ObserveNextReconcile(scheduledPodScalerName, 90*time.Minute)
+		want := `
+# HELP scheduled_scaler_next_reconcile_seconds Seconds until a rule starts or ends.
+# TYPE scheduled_scaler_next_reconcile_seconds gauge
+scheduled_scaler_next_reconcile_seconds{name="scaler1",namespace="default"} 5400
+`
+		if err := testutil.GatherAndCompare(registry, strings.NewReader(want), "scheduled_scaler_next_reconcile_seconds"); err != nil {
+			t.Errorf("GatherAndCompare error: %s", err)
+		}
+	})
+	t.Run("Delete", func(t *testing.T) {
+		m, registry := newRegisteredMetrics(t)
+		m.ObserveNextReconcile(scheduledPodScalerName, 90*time.Minute)
+		m.ObserveNextReconcile(scheduledPodScalerName, 0)
+		if err := testutil.GatherAndCompare(registry, strings.NewReader(""), "scheduled_scaler_next_reconcile_seconds"); err != nil {
+			t.Errorf("GatherAndCompare error: %s", err)
+		}
+	})
+}
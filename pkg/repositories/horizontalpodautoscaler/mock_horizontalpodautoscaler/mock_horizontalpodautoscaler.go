@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/int128/scheduled-scaler/pkg/repositories/horizontalpodautoscaler (interfaces: Interface)
+
+// Package mock_horizontalpodautoscaler is a generated GoMock package.
+package mock_horizontalpodautoscaler
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	v1 "k8s.io/api/autoscaling/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	reflect "reflect"
+)
+
+// MockInterface is a mock of Interface interface
+type MockInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockInterfaceMockRecorder
+}
+
+// MockInterfaceMockRecorder is the mock recorder for MockInterface
+type MockInterfaceMockRecorder struct {
+	mock *MockInterface
+}
+
+// NewMockInterface creates a new mock instance
+func NewMockInterface(ctrl *gomock.Controller) *MockInterface {
+	mock := &MockInterface{ctrl: ctrl}
+	mock.recorder = &MockInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockInterface) EXPECT() *MockInterfaceMockRecorder {
+	return m.recorder
+}
+
+// FindBySelectors mocks base method
+func (m *MockInterface) FindBySelectors(arg0 context.Context, arg1 string, arg2 labels.Selector) (*v1.HorizontalPodAutoscalerList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySelectors", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1.HorizontalPodAutoscalerList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySelectors indicates an expected call of FindBySelectors
+func (mr *MockInterfaceMockRecorder) FindBySelectors(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySelectors", reflect.TypeOf((*MockInterface)(nil).FindBySelectors), arg0, arg1, arg2)
+}
+
+// GetByName mocks base method
+func (m *MockInterface) GetByName(arg0 context.Context, arg1 types.NamespacedName) (*v1.HorizontalPodAutoscaler, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByName", arg0, arg1)
+	ret0, _ := ret[0].(*v1.HorizontalPodAutoscaler)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByName indicates an expected call of GetByName
+func (mr *MockInterfaceMockRecorder) GetByName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByName", reflect.TypeOf((*MockInterface)(nil).GetByName), arg0, arg1)
+}
+
+// Scale mocks base method
+func (m *MockInterface) Scale(arg0 context.Context, arg1 *v1.HorizontalPodAutoscaler, arg2, arg3 *int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Scale", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Scale indicates an expected call of Scale
+func (mr *MockInterfaceMockRecorder) Scale(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scale", reflect.TypeOf((*MockInterface)(nil).Scale), arg0, arg1, arg2, arg3)
+}
@@ -0,0 +1,83 @@
+package horizontalpodautoscaler
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/wire"
+	"github.com/int128/scheduled-scaler/pkg/infrastructure/errors"
+	"golang.org/x/xerrors"
+	kautoscaling "k8s.io/api/autoscaling/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var Set = wire.NewSet(
+	wire.Bind(new(Interface), new(*Repository)),
+	wire.Struct(new(Repository), "*"),
+)
+
+//go:generate mockgen -destination mock_horizontalpodautoscaler/mock_horizontalpodautoscaler.go github.com/int128/scheduled-scaler/pkg/repositories/horizontalpodautoscaler Interface
+
+type Interface interface {
+	GetByName(ctx context.Context, name types.NamespacedName) (*kautoscaling.HorizontalPodAutoscaler, error)
+	FindBySelectors(ctx context.Context, namespace string, selector labels.Selector) (*kautoscaling.HorizontalPodAutoscalerList, error)
+	Scale(ctx context.Context, hpa *kautoscaling.HorizontalPodAutoscaler, minReplicas, maxReplicas *int32) error
+}
+
+type Repository struct {
+	Client client.Client
+}
+
+// GetByName returns the HorizontalPodAutoscaler of the name.
+func (r *Repository) GetByName(ctx context.Context, name types.NamespacedName) (*kautoscaling.HorizontalPodAutoscaler, error) {
+	var o kautoscaling.HorizontalPodAutoscaler
+	if err := r.Client.Get(ctx, name, &o); err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return &o, nil
+}
+
+// FindBySelectors returns a list of HorizontalPodAutoscalers matched to the selector in the namespace.
+func (r *Repository) FindBySelectors(ctx context.Context, namespace string, selector labels.Selector) (*kautoscaling.HorizontalPodAutoscalerList, error) {
+	var l kautoscaling.HorizontalPodAutoscalerList
+	if err := r.Client.List(ctx, &l, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return &l, nil
+}
+
+// Scale updates the minReplicas and maxReplicas of the HorizontalPodAutoscaler using the patch method.
+// It does not change the field if nil is given.
+func (r *Repository) Scale(ctx context.Context, hpa *kautoscaling.HorizontalPodAutoscaler, minReplicas, maxReplicas *int32) error {
+	b, err := json.Marshal(&scaleMergePatch{
+		Spec: scaleMergePatchSpec{
+			MinReplicas: minReplicas,
+			MaxReplicas: maxReplicas,
+		},
+	})
+	if err != nil {
+		return xerrors.Errorf("could not encode the json: %w", err)
+	}
+	p := client.ConstantPatch(types.MergePatchType, b)
+	if err := r.Client.Patch(ctx, hpa, p); err != nil {
+		return errors.Wrap(err)
+	}
+	if minReplicas != nil {
+		hpa.Spec.MinReplicas = minReplicas
+	}
+	if maxReplicas != nil {
+		hpa.Spec.MaxReplicas = *maxReplicas
+	}
+	return nil
+}
+
+type scaleMergePatch struct {
+	Spec scaleMergePatchSpec `json:"spec"`
+}
+
+type scaleMergePatchSpec struct {
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/int128/scheduled-scaler/pkg/repositories/namespace (interfaces: Interface)
+
+// Package mock_namespace is a generated GoMock package.
+package mock_namespace
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	v1 "k8s.io/api/core/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	reflect "reflect"
+)
+
+// MockInterface is a mock of Interface interface
+type MockInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockInterfaceMockRecorder
+}
+
+// MockInterfaceMockRecorder is the mock recorder for MockInterface
+type MockInterfaceMockRecorder struct {
+	mock *MockInterface
+}
+
+// NewMockInterface creates a new mock instance
+func NewMockInterface(ctrl *gomock.Controller) *MockInterface {
+	mock := &MockInterface{ctrl: ctrl}
+	mock.recorder = &MockInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockInterface) EXPECT() *MockInterfaceMockRecorder {
+	return m.recorder
+}
+
+// FindBySelector mocks base method
+func (m *MockInterface) FindBySelector(arg0 context.Context, arg1 labels.Selector) (*v1.NamespaceList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySelector", arg0, arg1)
+	ret0, _ := ret[0].(*v1.NamespaceList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySelector indicates an expected call of FindBySelector
+func (mr *MockInterfaceMockRecorder) FindBySelector(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySelector", reflect.TypeOf((*MockInterface)(nil).FindBySelector), arg0, arg1)
+}
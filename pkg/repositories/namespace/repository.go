@@ -0,0 +1,35 @@
+package namespace
+
+import (
+	"context"
+
+	"github.com/google/wire"
+	"github.com/int128/scheduled-scaler/pkg/infrastructure/errors"
+	kcore "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var Set = wire.NewSet(
+	wire.Bind(new(Interface), new(*Repository)),
+	wire.Struct(new(Repository), "*"),
+)
+
+//go:generate mockgen -destination mock_namespace/mock_namespace.go github.com/int128/scheduled-scaler/pkg/repositories/namespace Interface
+
+type Interface interface {
+	FindBySelector(ctx context.Context, selector labels.Selector) (*kcore.NamespaceList, error)
+}
+
+type Repository struct {
+	Client client.Client
+}
+
+// FindBySelector returns a list of namespaces matched to the selector.
+func (r *Repository) FindBySelector(ctx context.Context, selector labels.Selector) (*kcore.NamespaceList, error) {
+	var l kcore.NamespaceList
+	if err := r.Client.List(ctx, &l, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return &l, nil
+}
@@ -2,6 +2,7 @@ package scheduledpodscaler
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/google/wire"
@@ -10,7 +11,12 @@ import (
 	"github.com/int128/scheduled-scaler/pkg/domain/scheduledpodscaler"
 	"github.com/int128/scheduled-scaler/pkg/infrastructure/errors"
 	"golang.org/x/xerrors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -31,60 +37,554 @@ type Repository struct {
 }
 
 // GetByName returns the ScheduledPodScaler of the name.
+// If the spec is invalid, it returns the ScheduledPodScaler without the spec
+// and an error which implements domain/errors.Invalid.
 func (r *Repository) GetByName(ctx context.Context, name types.NamespacedName) (*scheduledpodscaler.ScheduledPodScaler, error) {
 	var o scheduledscalingv1.ScheduledPodScaler
 	if err := r.Client.Get(ctx, name, &o); err != nil {
 		return nil, errors.Wrap(err)
 	}
 	var s scheduledpodscaler.ScheduledPodScaler
-	s.TypeMeta, s.ObjectMeta = o.TypeMeta, o.ObjectMeta
+	// the client may not set TypeMeta of a typed object
+	s.TypeMeta = metav1.TypeMeta{APIVersion: scheduledscalingv1.GroupVersion.String(), Kind: "ScheduledPodScaler"}
+	s.ObjectMeta = o.ObjectMeta
 
-	s.Spec.ScaleTarget.Selectors = o.Spec.ScaleTarget.Selectors
-
-	for _, rule := range o.Spec.ScaleRules {
-		var rng schedule.Range
-		var err error
-		switch {
-		case rule.Daily != nil:
-			rng, err = schedule.NewDailyRange(rule.Daily.StartTime, rule.Daily.EndTime)
-			if err != nil {
-				return nil, xerrors.Errorf("invalid daily syntax: %w", err)
-			}
-		default:
-			return nil, xerrors.Errorf("currently only daily is supported")
+	if o.Status.NextReconcileTime != "" {
+		t, err := time.Parse(time.RFC3339, o.Status.NextReconcileTime)
+		if err != nil {
+			return nil, xerrors.Errorf("could not parse Status.NextReconcileTime: %w", err)
+		}
+		s.Status.NextReconcileTime = t
+	}
+	s.Status.ActiveRule = o.Status.ActiveRule
+	if o.Status.DesiredReplicas != nil || o.Status.DesiredPercentage != nil || o.Status.DesiredMinReplicas != nil || o.Status.DesiredMaxReplicas != nil {
+		s.Status.DesiredScaleSpec = &scheduledpodscaler.ScaleSpec{
+			Replicas:    pointer.Int32PtrDerefOr(o.Status.DesiredReplicas, 0),
+			MinReplicas: o.Status.DesiredMinReplicas,
+			MaxReplicas: o.Status.DesiredMaxReplicas,
+		}
+		if o.Status.DesiredPercentage != nil {
+			s.Status.DesiredScaleSpec.Percentage = &scheduledpodscaler.Percentage{Value: *o.Status.DesiredPercentage}
 		}
-		tz, err := time.LoadLocation(rule.Timezone)
+	}
+	if o.Status.LastScaleTime != "" {
+		t, err := time.Parse(time.RFC3339, o.Status.LastScaleTime)
 		if err != nil {
-			return nil, xerrors.Errorf("invalid timezone: %w", err)
+			return nil, xerrors.Errorf("could not parse Status.LastScaleTime: %w", err)
 		}
-		s.Spec.ScaleRules = append(s.Spec.ScaleRules, scheduledpodscaler.ScaleRule{
-			Range:    rng,
-			Timezone: tz,
-			ScaleSpec: scheduledpodscaler.ScaleSpec{
-				Replicas: rule.ScaleSpec.Replicas,
+		s.Status.LastScaleTime = t
+	}
+	for _, target := range o.Status.Targets {
+		ts := scheduledpodscaler.TargetStatus{
+			Namespace: target.Namespace,
+			Name:      target.Name,
+			Current: scheduledpodscaler.ScaleSpec{
+				Replicas:    pointer.Int32PtrDerefOr(target.Replicas, 0),
+				MinReplicas: target.MinReplicas,
+				MaxReplicas: target.MaxReplicas,
+			},
+			Desired: scheduledpodscaler.ScaleSpec{
+				Replicas:    pointer.Int32PtrDerefOr(target.DesiredReplicas, 0),
+				MinReplicas: target.DesiredMinReplicas,
+				MaxReplicas: target.DesiredMaxReplicas,
 			},
+			Error:   target.Error,
+			Skipped: target.Skipped,
+		}
+		if target.LastScaleTime != "" {
+			t, err := time.Parse(time.RFC3339, target.LastScaleTime)
+			if err != nil {
+				return nil, xerrors.Errorf("could not parse Status.Targets.LastScaleTime: %w", err)
+			}
+			ts.LastScaleTime = t
+		}
+		if target.NextStepTime != "" {
+			t, err := time.Parse(time.RFC3339, target.NextStepTime)
+			if err != nil {
+				return nil, xerrors.Errorf("could not parse Status.Targets.NextStepTime: %w", err)
+			}
+			ts.NextStepTime = t
+		}
+		if target.ScaleDownTime != "" {
+			t, err := time.Parse(time.RFC3339, target.ScaleDownTime)
+			if err != nil {
+				return nil, xerrors.Errorf("could not parse Status.Targets.ScaleDownTime: %w", err)
+			}
+			ts.ScaleDownTime = t
+		}
+		s.Status.Targets = append(s.Status.Targets, ts)
+	}
+	for _, c := range o.Status.Conditions {
+		s.Status.Conditions = append(s.Status.Conditions, scheduledpodscaler.Condition{
+			Type:               scheduledpodscaler.ConditionType(c.Type),
+			Status:             c.Status,
+			LastTransitionTime: c.LastTransitionTime.Time,
+			Reason:             c.Reason,
+			Message:            c.Message,
 		})
 	}
 
-	s.Spec.DefaultScaleSpec.Replicas = o.Spec.DefaultScaleSpec.Replicas
+	spec, err := ParseSpec(o.Spec)
+	if err != nil {
+		return &s, &invalidSpecError{xerrors.Errorf("invalid spec: %w", err)}
+	}
+	s.Spec = *spec
+	return &s, nil
+}
 
-	if o.Status.NextReconcileTime != "" {
-		t, err := time.Parse(time.RFC3339, o.Status.NextReconcileTime)
+type invalidSpecError struct {
+	error
+}
+
+func (err *invalidSpecError) IsInvalid() bool {
+	return true
+}
+
+// ParseSpec parses the spec of ScheduledPodScaler.
+// It returns an error if the spec is invalid.
+func ParseSpec(o scheduledscalingv1.ScheduledPodScalerSpec) (*scheduledpodscaler.Spec, error) {
+	var s scheduledpodscaler.Spec
+	gvk, err := parseGroupVersionKind(o.ScaleTarget.APIVersion, o.ScaleTarget.Kind)
+	if err != nil {
+		return nil, xerrors.Errorf("invalid scaleTarget: %w", err)
+	}
+	s.ScaleTarget.GroupVersionKind = gvk
+	if o.ScaleTarget.Name != "" {
+		if o.ScaleTarget.Selectors != nil || o.ScaleTarget.Selector != nil {
+			return nil, xerrors.New("invalid scaleTarget: name is mutually exclusive with selectors and selector")
+		}
+		s.ScaleTarget.Name = o.ScaleTarget.Name
+	} else {
+		s.ScaleTarget.Selector, err = parseSelector(o.ScaleTarget)
 		if err != nil {
-			return nil, xerrors.Errorf("could not parse Status.NextReconcileTime: %w", err)
+			return nil, xerrors.Errorf("invalid selector: %w", err)
+		}
+	}
+	if o.ScaleTarget.NamespaceSelector != nil {
+		s.ScaleTarget.NamespaceSelector, err = metav1.LabelSelectorAsSelector(o.ScaleTarget.NamespaceSelector)
+		if err != nil {
+			return nil, xerrors.Errorf("invalid namespaceSelector: %w", err)
 		}
-		s.Status.NextReconcileTime = t
 	}
 
+	ruleNames := make(map[string]int)
+	for i, rule := range o.ScaleRules {
+		scaleRule, err := parseScaleRule(rule, s.ScaleTarget)
+		if err != nil {
+			return nil, xerrors.Errorf("invalid schedule[%d]: %w", i, err)
+		}
+		if scaleRule.Name != "" {
+			if j, ok := ruleNames[scaleRule.Name]; ok {
+				return nil, xerrors.Errorf("invalid schedule[%d]: name %s is already used by schedule[%d]", i, scaleRule.Name, j)
+			}
+			ruleNames[scaleRule.Name] = i
+		}
+		s.ScaleRules = append(s.ScaleRules, scaleRule)
+	}
+
+	s.DefaultScaleSpec, err = parseScaleSpec(o.DefaultScaleSpec, s.ScaleTarget)
+	if err != nil {
+		return nil, xerrors.Errorf("invalid default: %w", err)
+	}
+	s.ConflictPolicy, err = parseConflictPolicy(o.ConflictPolicy, s)
+	if err != nil {
+		return nil, xerrors.Errorf("invalid conflictPolicy: %w", err)
+	}
+	switch m := scheduledpodscaler.DefaultMode(o.DefaultMode); m {
+	case "":
+		s.DefaultMode = scheduledpodscaler.DefaultModeSpec
+	case scheduledpodscaler.DefaultModeSpec, scheduledpodscaler.DefaultModeRestore:
+		s.DefaultMode = m
+	default:
+		return nil, xerrors.Errorf("invalid defaultMode: must be one of spec or restore but was %s", o.DefaultMode)
+	}
+	s.DryRun = o.DryRun
+	s.Suspend = o.Suspend
+	if o.SuspendUntil != "" {
+		s.SuspendUntil, err = time.Parse(time.RFC3339, o.SuspendUntil)
+		if err != nil {
+			return nil, xerrors.Errorf("invalid suspendUntil: %w", err)
+		}
+	}
+	s.RampUp, err = parseRampPolicy(o.RampUp)
+	if err != nil {
+		return nil, xerrors.Errorf("invalid rampUp: %w", err)
+	}
+	s.RampDown, err = parseRampPolicy(o.RampDown)
+	if err != nil {
+		return nil, xerrors.Errorf("invalid rampDown: %w", err)
+	}
+	s.ScaleDownDelay, err = parseScaleDownDelay(o.ScaleDownDelay)
+	if err != nil {
+		return nil, xerrors.Errorf("invalid scaleDownDelay: %w", err)
+	}
+	if s.ScaleTarget.IsHorizontalPodAutoscaler() {
+		if err := validateHorizontalPodAutoscalerBehavior(s.RampUp, s.RampDown, s.ScaleDownDelay); err != nil {
+			return nil, err
+		}
+		if s.DefaultMode == scheduledpodscaler.DefaultModeRestore {
+			return nil, xerrors.Errorf("invalid defaultMode: %s cannot be used for HorizontalPodAutoscaler", s.DefaultMode)
+		}
+	}
 	return &s, nil
 }
 
+// validateHorizontalPodAutoscalerBehavior returns an error if a behavior for the replicas is set,
+// because a HorizontalPodAutoscaler is scaled by minReplicas and maxReplicas.
+func validateHorizontalPodAutoscalerBehavior(rampUp, rampDown *scheduledpodscaler.RampPolicy, scaleDownDelay time.Duration) error {
+	if rampUp != nil {
+		return xerrors.New("invalid rampUp: cannot be used for HorizontalPodAutoscaler")
+	}
+	if rampDown != nil {
+		return xerrors.New("invalid rampDown: cannot be used for HorizontalPodAutoscaler")
+	}
+	if scaleDownDelay > 0 {
+		return xerrors.New("invalid scaleDownDelay: cannot be used for HorizontalPodAutoscaler")
+	}
+	return nil
+}
+
+func parseScaleRule(rule scheduledscalingv1.ScaleRule, target scheduledpodscaler.ScaleTarget) (scheduledpodscaler.ScaleRule, error) {
+	if err := validateRuleName(rule.Name); err != nil {
+		return scheduledpodscaler.ScaleRule{}, xerrors.Errorf("invalid name: %w", err)
+	}
+	tz, err := time.LoadLocation(rule.Timezone)
+	if err != nil {
+		return scheduledpodscaler.ScaleRule{}, xerrors.Errorf("invalid timezone: %w", err)
+	}
+	if countRanges(rule) != 1 {
+		return scheduledpodscaler.ScaleRule{}, xerrors.New("exactly one of daily, weekly, cron or absolute must be set")
+	}
+	var rng schedule.Range
+	switch {
+	case rule.Daily != nil:
+		rng, err = schedule.NewDailyRange(rule.Daily.StartTime, rule.Daily.EndTime)
+		if err != nil {
+			return scheduledpodscaler.ScaleRule{}, xerrors.Errorf("invalid daily syntax: %w", err)
+		}
+	case rule.Weekly != nil:
+		rng, err = schedule.NewWeeklyRange(rule.Weekly.Days, rule.Weekly.StartTime, rule.Weekly.EndTime)
+		if err != nil {
+			return scheduledpodscaler.ScaleRule{}, xerrors.Errorf("invalid weekly syntax: %w", err)
+		}
+	case rule.Cron != nil:
+		rng, err = schedule.NewCronRange(rule.Cron.Start, rule.Cron.End, rule.Cron.Duration)
+		if err != nil {
+			return scheduledpodscaler.ScaleRule{}, xerrors.Errorf("invalid cron syntax: %w", err)
+		}
+	case rule.Absolute != nil && isAbsoluteDate(*rule.Absolute):
+		if rule.Absolute.StartTime != "" || rule.Absolute.EndTime != "" {
+			return scheduledpodscaler.ScaleRule{}, xerrors.New("invalid absolute syntax: startTime and endTime are mutually exclusive with startDate and endDate")
+		}
+		rng, err = schedule.NewAbsoluteDateRange(rule.Absolute.StartDate, rule.Absolute.EndDate, tz)
+		if err != nil {
+			return scheduledpodscaler.ScaleRule{}, xerrors.Errorf("invalid absolute syntax: %w", err)
+		}
+	case rule.Absolute != nil:
+		rng, err = schedule.NewAbsoluteRange(rule.Absolute.StartTime, rule.Absolute.EndTime)
+		if err != nil {
+			return scheduledpodscaler.ScaleRule{}, xerrors.Errorf("invalid absolute syntax: %w", err)
+		}
+	}
+	if rule.LeadTime != "" {
+		leadTime, err := time.ParseDuration(rule.LeadTime)
+		if err != nil {
+			return scheduledpodscaler.ScaleRule{}, xerrors.Errorf("invalid leadTime: %w", err)
+		}
+		if leadTime < 0 {
+			return scheduledpodscaler.ScaleRule{}, xerrors.New("leadTime must not be negative")
+		}
+		rng = &schedule.LeadTimeRange{Range: rng, LeadTime: leadTime}
+	}
+	scaleSpec, err := parseScaleSpec(rule.ScaleSpec, target)
+	if err != nil {
+		return scheduledpodscaler.ScaleRule{}, xerrors.Errorf("invalid spec: %w", err)
+	}
+	rampUp, err := parseRampPolicy(rule.RampUp)
+	if err != nil {
+		return scheduledpodscaler.ScaleRule{}, xerrors.Errorf("invalid rampUp: %w", err)
+	}
+	rampDown, err := parseRampPolicy(rule.RampDown)
+	if err != nil {
+		return scheduledpodscaler.ScaleRule{}, xerrors.Errorf("invalid rampDown: %w", err)
+	}
+	scaleDownDelay, err := parseScaleDownDelay(rule.ScaleDownDelay)
+	if err != nil {
+		return scheduledpodscaler.ScaleRule{}, xerrors.Errorf("invalid scaleDownDelay: %w", err)
+	}
+	mode, err := parseScaleMode(rule.Mode)
+	if err != nil {
+		return scheduledpodscaler.ScaleRule{}, xerrors.Errorf("invalid mode: %w", err)
+	}
+	if target.IsHorizontalPodAutoscaler() {
+		if err := validateHorizontalPodAutoscalerBehavior(rampUp, rampDown, scaleDownDelay); err != nil {
+			return scheduledpodscaler.ScaleRule{}, err
+		}
+		if mode != scheduledpodscaler.ScaleModeExact {
+			return scheduledpodscaler.ScaleRule{}, xerrors.Errorf("mode %s cannot be used for HorizontalPodAutoscaler", mode)
+		}
+	}
+	return scheduledpodscaler.ScaleRule{
+		Name:           rule.Name,
+		Range:          rng,
+		Timezone:       tz,
+		ScaleSpec:      scaleSpec,
+		RampUp:         rampUp,
+		RampDown:       rampDown,
+		ScaleDownDelay: scaleDownDelay,
+		Mode:           mode,
+		Priority:       rule.Priority,
+	}, nil
+}
+
+// isAbsoluteDate returns true if the rule has startDate or endDate.
+func isAbsoluteDate(rule scheduledscalingv1.AbsoluteRule) bool {
+	return rule.StartDate != "" || rule.EndDate != ""
+}
+
+// countRanges returns the number of daily, weekly, cron and absolute set in the rule.
+func countRanges(rule scheduledscalingv1.ScaleRule) int {
+	var n int
+	for _, set := range []bool{rule.Daily != nil, rule.Weekly != nil, rule.Cron != nil, rule.Absolute != nil} {
+		if set {
+			n++
+		}
+	}
+	return n
+}
+
+func validateRuleName(name string) error {
+	if name == "" {
+		return nil
+	}
+	if name == scheduledpodscaler.DefaultRuleName {
+		return xerrors.Errorf("%s is reserved", name)
+	}
+	if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+		return xerrors.Errorf("%s", strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+func parseConflictPolicy(p string, s scheduledpodscaler.Spec) (scheduledpodscaler.ConflictPolicy, error) {
+	switch policy := scheduledpodscaler.ConflictPolicy(p); policy {
+	case "":
+		return scheduledpodscaler.ConflictPolicyFirst, nil
+	case scheduledpodscaler.ConflictPolicyFirst, scheduledpodscaler.ConflictPolicyHighestPriority:
+		return policy, nil
+	case scheduledpodscaler.ConflictPolicyMaxReplicas, scheduledpodscaler.ConflictPolicyMinReplicas:
+		if s.ScaleTarget.IsHorizontalPodAutoscaler() {
+			return "", xerrors.Errorf("%s cannot be used for HorizontalPodAutoscaler", policy)
+		}
+		var percentages int
+		for _, rule := range s.ScaleRules {
+			if rule.ScaleSpec.Percentage != nil {
+				percentages++
+			}
+		}
+		if percentages > 0 && percentages < len(s.ScaleRules) {
+			return "", xerrors.Errorf("%s cannot compare the rules of replicas and percentage", policy)
+		}
+		return policy, nil
+	}
+	return "", xerrors.Errorf("must be one of first, highestPriority, maxReplicas or minReplicas but was %s", p)
+}
+
+func parseScaleMode(s string) (scheduledpodscaler.ScaleMode, error) {
+	switch m := scheduledpodscaler.ScaleMode(s); m {
+	case "":
+		return scheduledpodscaler.ScaleModeExact, nil
+	case scheduledpodscaler.ScaleModeExact, scheduledpodscaler.ScaleModeAtLeast, scheduledpodscaler.ScaleModeAtMost:
+		return m, nil
+	}
+	return "", xerrors.Errorf("mode must be one of exact, atLeast or atMost but was %s", s)
+}
+
+// parseScaleDownDelay returns the duration or zero if it is not set.
+func parseScaleDownDelay(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, xerrors.Errorf("could not parse the duration: %w", err)
+	}
+	if d < 0 {
+		return 0, xerrors.New("must not be negative")
+	}
+	return d, nil
+}
+
+// parseRampPolicy returns the RampPolicy or nil if it is not set.
+func parseRampPolicy(o *scheduledscalingv1.RampPolicy) (*scheduledpodscaler.RampPolicy, error) {
+	if o == nil {
+		return nil, nil
+	}
+	if o.MaxStep <= 0 {
+		return nil, xerrors.New("maxStep must be positive")
+	}
+	interval, err := time.ParseDuration(o.Interval)
+	if err != nil {
+		return nil, xerrors.Errorf("invalid interval: %w", err)
+	}
+	if interval <= 0 {
+		return nil, xerrors.New("interval must be positive")
+	}
+	return &scheduledpodscaler.RampPolicy{MaxStep: o.MaxStep, Interval: interval}, nil
+}
+
+func parseGroupVersionKind(apiVersion, kind string) (schema.GroupVersionKind, error) {
+	if kind == "" {
+		kind = "Deployment"
+	}
+	if apiVersion == "" {
+		apiVersion = "apps/v1"
+		if kind == "HorizontalPodAutoscaler" {
+			apiVersion = "autoscaling/v1"
+		}
+	}
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return schema.GroupVersionKind{}, xerrors.Errorf("invalid apiVersion: %w", err)
+	}
+	return gv.WithKind(kind), nil
+}
+
+// parseSelector returns a selector which matches both selectors and selector of the target.
+func parseSelector(target scheduledscalingv1.ScaleTarget) (labels.Selector, error) {
+	selector := labels.SelectorFromSet(target.Selectors)
+	if target.Selector == nil {
+		return selector, nil
+	}
+	labelSelector, err := metav1.LabelSelectorAsSelector(target.Selector)
+	if err != nil {
+		return nil, xerrors.Errorf("invalid label selector: %w", err)
+	}
+	requirements, _ := labelSelector.Requirements()
+	return selector.Add(requirements...), nil
+}
+
+func parseScaleSpec(spec scheduledscalingv1.ScaleSpec, target scheduledpodscaler.ScaleTarget) (scheduledpodscaler.ScaleSpec, error) {
+	if target.IsHorizontalPodAutoscaler() {
+		if spec.MinReplicas == nil && spec.MaxReplicas == nil {
+			return scheduledpodscaler.ScaleSpec{}, xerrors.Errorf("minReplicas or maxReplicas must be set for HorizontalPodAutoscaler")
+		}
+		if spec.Replicas != 0 {
+			return scheduledpodscaler.ScaleSpec{}, xerrors.Errorf("replicas cannot be used for HorizontalPodAutoscaler")
+		}
+		if spec.Percentage != nil {
+			return scheduledpodscaler.ScaleSpec{}, xerrors.Errorf("percentage cannot be used for HorizontalPodAutoscaler")
+		}
+	} else if spec.MinReplicas != nil || spec.MaxReplicas != nil {
+		return scheduledpodscaler.ScaleSpec{}, xerrors.Errorf("minReplicas and maxReplicas can be used only for HorizontalPodAutoscaler")
+	}
+	if spec.Replicas < 0 {
+		return scheduledpodscaler.ScaleSpec{}, xerrors.Errorf("replicas must not be negative")
+	}
+	if spec.MinReplicas != nil && *spec.MinReplicas < 0 {
+		return scheduledpodscaler.ScaleSpec{}, xerrors.Errorf("minReplicas must not be negative")
+	}
+	if spec.MaxReplicas != nil && *spec.MaxReplicas < 0 {
+		return scheduledpodscaler.ScaleSpec{}, xerrors.Errorf("maxReplicas must not be negative")
+	}
+	if spec.MinReplicas != nil && spec.MaxReplicas != nil && *spec.MinReplicas > *spec.MaxReplicas {
+		return scheduledpodscaler.ScaleSpec{}, xerrors.Errorf("minReplicas must not be greater than maxReplicas")
+	}
+	percentage, err := parsePercentage(spec.Percentage)
+	if err != nil {
+		return scheduledpodscaler.ScaleSpec{}, xerrors.Errorf("invalid percentage: %w", err)
+	}
+	return scheduledpodscaler.ScaleSpec{
+		Replicas:    spec.Replicas,
+		MinReplicas: spec.MinReplicas,
+		MaxReplicas: spec.MaxReplicas,
+		Percentage:  percentage,
+	}, nil
+}
+
+func parsePercentage(p *scheduledscalingv1.PercentageSpec) (*scheduledpodscaler.Percentage, error) {
+	if p == nil {
+		return nil, nil
+	}
+	if p.Value < 0 {
+		return nil, xerrors.Errorf("value must not be negative")
+	}
+	if p.Min < 0 {
+		return nil, xerrors.Errorf("min must not be negative")
+	}
+	rounding := scheduledpodscaler.Rounding(p.Rounding)
+	switch rounding {
+	case "":
+		rounding = scheduledpodscaler.RoundingUp
+	case scheduledpodscaler.RoundingUp, scheduledpodscaler.RoundingDown, scheduledpodscaler.RoundingNearest:
+	default:
+		return nil, xerrors.Errorf("rounding must be one of up, down or nearest but was %s", p.Rounding)
+	}
+	return &scheduledpodscaler.Percentage{
+		Value:    p.Value,
+		Rounding: rounding,
+		Min:      p.Min,
+	}, nil
+}
+
 // UpdateStatus updates the status. It does not update the spec.
 func (r *Repository) UpdateStatus(ctx context.Context, s *scheduledpodscaler.ScheduledPodScaler) error {
 	var o scheduledscalingv1.ScheduledPodScaler
 	o.TypeMeta, o.ObjectMeta = s.TypeMeta, s.ObjectMeta
 
-	o.Status.NextReconcileTime = s.Status.NextReconcileTime.Format(time.RFC3339)
+	if !s.Status.NextReconcileTime.IsZero() {
+		o.Status.NextReconcileTime = s.Status.NextReconcileTime.Format(time.RFC3339)
+	}
+	o.Status.ActiveRule = s.Status.ActiveRule
+	if s.Status.DesiredScaleSpec != nil {
+		if s.Spec.ScaleTarget.IsHorizontalPodAutoscaler() {
+			o.Status.DesiredMinReplicas = s.Status.DesiredScaleSpec.MinReplicas
+			o.Status.DesiredMaxReplicas = s.Status.DesiredScaleSpec.MaxReplicas
+		} else if s.Status.DesiredScaleSpec.Percentage != nil {
+			o.Status.DesiredPercentage = pointer.Int32Ptr(s.Status.DesiredScaleSpec.Percentage.Value)
+		} else {
+			o.Status.DesiredReplicas = pointer.Int32Ptr(s.Status.DesiredScaleSpec.Replicas)
+		}
+	}
+	if !s.Status.LastScaleTime.IsZero() {
+		o.Status.LastScaleTime = s.Status.LastScaleTime.Format(time.RFC3339)
+	}
+	for _, ts := range s.Status.Targets {
+		target := scheduledscalingv1.ScheduledPodScalerTargetStatus{
+			Namespace: ts.Namespace,
+			Name:      ts.Name,
+			Error:     ts.Error,
+			Skipped:   ts.Skipped,
+		}
+		if ts.IsHorizontalPodAutoscaler() {
+			target.MinReplicas = ts.Current.MinReplicas
+			target.MaxReplicas = ts.Current.MaxReplicas
+			target.DesiredMinReplicas = ts.Desired.MinReplicas
+			target.DesiredMaxReplicas = ts.Desired.MaxReplicas
+		} else {
+			target.Replicas = pointer.Int32Ptr(ts.Current.Replicas)
+			target.DesiredReplicas = pointer.Int32Ptr(ts.Desired.Replicas)
+		}
+		if !ts.LastScaleTime.IsZero() {
+			target.LastScaleTime = ts.LastScaleTime.Format(time.RFC3339)
+		}
+		if !ts.NextStepTime.IsZero() {
+			target.NextStepTime = ts.NextStepTime.Format(time.RFC3339)
+		}
+		if !ts.ScaleDownTime.IsZero() {
+			target.ScaleDownTime = ts.ScaleDownTime.Format(time.RFC3339)
+		}
+		o.Status.Targets = append(o.Status.Targets, target)
+	}
+	for _, c := range s.Status.Conditions {
+		o.Status.Conditions = append(o.Status.Conditions, scheduledscalingv1.ScheduledPodScalerCondition{
+			Type:               string(c.Type),
+			Status:             c.Status,
+			LastTransitionTime: metav1.NewTime(c.LastTransitionTime),
+			Reason:             c.Reason,
+			Message:            c.Message,
+		})
+	}
 
 	if err := r.Client.Status().Update(ctx, &o); err != nil {
 		return errors.Wrap(err)
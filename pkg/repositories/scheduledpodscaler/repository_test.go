@@ -0,0 +1,371 @@
+package scheduledpodscaler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	scheduledscalingv1 "github.com/int128/scheduled-scaler/api/v1"
+	"github.com/int128/scheduled-scaler/pkg/domain/scheduledpodscaler"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestParseSpec(t *testing.T) {
+	validRule := scheduledscalingv1.ScaleRule{
+		Daily: &scheduledscalingv1.DailyRule{
+			StartTime: "09:00:00",
+			EndTime:   "18:00:00",
+		},
+		Timezone: "Asia/Tokyo",
+		ScaleSpec: scheduledscalingv1.ScaleSpec{
+			Replicas: 3,
+		},
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		spec := scheduledscalingv1.ScheduledPodScalerSpec{
+			ScaleTarget: scheduledscalingv1.ScaleTarget{
+				Selectors: map[string]string{"app": "server1"},
+			},
+			ScaleRules: []scheduledscalingv1.ScaleRule{validRule},
+		}
+		if _, err := ParseSpec(spec); err != nil {
+			t.Errorf("ParseSpec error: %+v", err)
+		}
+	})
+	t.Run("HorizontalPodAutoscalerWithoutAPIVersion", func(t *testing.T) {
+		spec := scheduledscalingv1.ScheduledPodScalerSpec{
+			ScaleTarget: scheduledscalingv1.ScaleTarget{
+				Kind: "HorizontalPodAutoscaler",
+				Name: "server1",
+			},
+			ScaleRules: []scheduledscalingv1.ScaleRule{
+				{
+					Daily:     validRule.Daily,
+					ScaleSpec: scheduledscalingv1.ScaleSpec{MinReplicas: pointer.Int32Ptr(10)},
+				},
+			},
+			DefaultScaleSpec: scheduledscalingv1.ScaleSpec{MinReplicas: pointer.Int32Ptr(1)},
+		}
+		s, err := ParseSpec(spec)
+		if err != nil {
+			t.Fatalf("ParseSpec error: %+v", err)
+		}
+		if !s.ScaleTarget.IsHorizontalPodAutoscaler() {
+			t.Errorf("IsHorizontalPodAutoscaler wants true but was false: %s", s.ScaleTarget.GroupVersionKind)
+		}
+	})
+
+	hpaTarget := scheduledscalingv1.ScaleTarget{
+		APIVersion: "autoscaling/v1",
+		Kind:       "HorizontalPodAutoscaler",
+		Name:       "server1",
+	}
+
+	invalidSpecs := map[string]scheduledscalingv1.ScheduledPodScalerSpec{
+		"InvalidStartTime": {
+			ScaleRules: []scheduledscalingv1.ScaleRule{
+				{
+					Daily: &scheduledscalingv1.DailyRule{
+						StartTime: "9:00",
+						EndTime:   "18:00:00",
+					},
+				},
+			},
+		},
+		"InvalidTimezone": {
+			ScaleRules: []scheduledscalingv1.ScaleRule{
+				{
+					Daily:    validRule.Daily,
+					Timezone: "Asia/Tokio",
+				},
+			},
+		},
+		"NoRange": {
+			ScaleRules: []scheduledscalingv1.ScaleRule{
+				{Timezone: "Asia/Tokyo"},
+			},
+		},
+		"AbsoluteTimeAndDate": {
+			ScaleRules: []scheduledscalingv1.ScaleRule{
+				{
+					Absolute: &scheduledscalingv1.AbsoluteRule{
+						StartTime: "2019-12-24T09:00:00+09:00",
+						EndTime:   "2019-12-24T18:00:00+09:00",
+						StartDate: "2019-12-24",
+						EndDate:   "2019-12-25",
+					},
+				},
+			},
+		},
+		"AbsoluteStartTimeAndEndDate": {
+			ScaleRules: []scheduledscalingv1.ScaleRule{
+				{
+					Absolute: &scheduledscalingv1.AbsoluteRule{
+						StartTime: "2019-12-24T09:00:00+09:00",
+						EndDate:   "2019-12-25",
+					},
+				},
+			},
+		},
+		"DailyAndCron": {
+			ScaleRules: []scheduledscalingv1.ScaleRule{
+				{
+					Daily: validRule.Daily,
+					Cron:  &scheduledscalingv1.CronRule{Start: "0 9 * * *", End: "0 18 * * *"},
+				},
+			},
+		},
+		"NegativeReplicas": {
+			ScaleRules: []scheduledscalingv1.ScaleRule{
+				{
+					Daily: validRule.Daily,
+					ScaleSpec: scheduledscalingv1.ScaleSpec{
+						Replicas: -1,
+					},
+				},
+			},
+		},
+		"NegativeDefaultMinReplicas": {
+			ScaleTarget: hpaTarget,
+			DefaultScaleSpec: scheduledscalingv1.ScaleSpec{
+				MinReplicas: pointer.Int32Ptr(-1),
+			},
+		},
+		"InvalidSuspendUntil": {
+			Suspend:      true,
+			SuspendUntil: "2019-12-24 09:00",
+		},
+		"NegativeLeadTime": {
+			ScaleRules: []scheduledscalingv1.ScaleRule{
+				{
+					Daily:    validRule.Daily,
+					LeadTime: "-10m",
+				},
+			},
+		},
+		"NegativePercentage": {
+			ScaleRules: []scheduledscalingv1.ScaleRule{
+				{
+					Daily: validRule.Daily,
+					ScaleSpec: scheduledscalingv1.ScaleSpec{
+						Percentage: &scheduledscalingv1.PercentageSpec{Value: -25},
+					},
+				},
+			},
+		},
+		"UnknownRounding": {
+			DefaultScaleSpec: scheduledscalingv1.ScaleSpec{
+				Percentage: &scheduledscalingv1.PercentageSpec{Value: 25, Rounding: "ceil"},
+			},
+		},
+		"InvalidRuleName": {
+			ScaleRules: []scheduledscalingv1.ScaleRule{
+				{
+					Name:  "Weekday_Daytime",
+					Daily: validRule.Daily,
+				},
+			},
+		},
+		"ReservedRuleName": {
+			ScaleRules: []scheduledscalingv1.ScaleRule{
+				{
+					Name:  "default",
+					Daily: validRule.Daily,
+				},
+			},
+		},
+		"DuplicatedRuleName": {
+			ScaleRules: []scheduledscalingv1.ScaleRule{
+				{
+					Name:  "daytime",
+					Daily: validRule.Daily,
+				},
+				{
+					Name:  "daytime",
+					Daily: validRule.Daily,
+				},
+			},
+		},
+		"UnknownConflictPolicy": {
+			ConflictPolicy: "last",
+		},
+		"MaxReplicasOfHorizontalPodAutoscaler": {
+			ScaleTarget:      hpaTarget,
+			DefaultScaleSpec: scheduledscalingv1.ScaleSpec{MinReplicas: pointer.Int32Ptr(1)},
+			ConflictPolicy:   "maxReplicas",
+		},
+		"MinReplicasOfDeployment": {
+			ScaleRules: []scheduledscalingv1.ScaleRule{
+				{
+					Daily: validRule.Daily,
+					ScaleSpec: scheduledscalingv1.ScaleSpec{
+						MinReplicas: pointer.Int32Ptr(3),
+					},
+				},
+			},
+		},
+		"ReplicasOfHorizontalPodAutoscaler": {
+			ScaleTarget: hpaTarget,
+			ScaleRules: []scheduledscalingv1.ScaleRule{
+				{
+					Daily: validRule.Daily,
+					ScaleSpec: scheduledscalingv1.ScaleSpec{
+						Replicas: 3,
+					},
+				},
+			},
+			DefaultScaleSpec: scheduledscalingv1.ScaleSpec{MinReplicas: pointer.Int32Ptr(1)},
+		},
+		"NoDefaultOfHorizontalPodAutoscaler": {
+			ScaleTarget: hpaTarget,
+		},
+		"PercentageOfHorizontalPodAutoscaler": {
+			ScaleTarget: hpaTarget,
+			DefaultScaleSpec: scheduledscalingv1.ScaleSpec{
+				MinReplicas: pointer.Int32Ptr(1),
+				Percentage:  &scheduledscalingv1.PercentageSpec{Value: 50},
+			},
+		},
+		"ModeOfHorizontalPodAutoscaler": {
+			ScaleTarget: hpaTarget,
+			ScaleRules: []scheduledscalingv1.ScaleRule{
+				{
+					Daily:     validRule.Daily,
+					ScaleSpec: scheduledscalingv1.ScaleSpec{MinReplicas: pointer.Int32Ptr(10)},
+					Mode:      "atLeast",
+				},
+			},
+			DefaultScaleSpec: scheduledscalingv1.ScaleSpec{MinReplicas: pointer.Int32Ptr(1)},
+		},
+		"RuleRampUpOfHorizontalPodAutoscaler": {
+			ScaleTarget: hpaTarget,
+			ScaleRules: []scheduledscalingv1.ScaleRule{
+				{
+					Daily:     validRule.Daily,
+					ScaleSpec: scheduledscalingv1.ScaleSpec{MinReplicas: pointer.Int32Ptr(10)},
+					RampUp:    &scheduledscalingv1.RampPolicy{MaxStep: 1, Interval: "1m"},
+				},
+			},
+			DefaultScaleSpec: scheduledscalingv1.ScaleSpec{MinReplicas: pointer.Int32Ptr(1)},
+		},
+		"RampDownOfHorizontalPodAutoscaler": {
+			ScaleTarget:      hpaTarget,
+			DefaultScaleSpec: scheduledscalingv1.ScaleSpec{MinReplicas: pointer.Int32Ptr(1)},
+			RampDown:         &scheduledscalingv1.RampPolicy{MaxStep: 1, Interval: "1m"},
+		},
+		"ScaleDownDelayOfHorizontalPodAutoscaler": {
+			ScaleTarget:      hpaTarget,
+			DefaultScaleSpec: scheduledscalingv1.ScaleSpec{MinReplicas: pointer.Int32Ptr(1)},
+			ScaleDownDelay:   "10m",
+		},
+		"RestoreOfHorizontalPodAutoscaler": {
+			ScaleTarget:      hpaTarget,
+			DefaultScaleSpec: scheduledscalingv1.ScaleSpec{MinReplicas: pointer.Int32Ptr(1)},
+			DefaultMode:      "restore",
+		},
+		"MinReplicasOfReplicasAndPercentage": {
+			ScaleRules: []scheduledscalingv1.ScaleRule{
+				validRule,
+				{
+					Daily: validRule.Daily,
+					ScaleSpec: scheduledscalingv1.ScaleSpec{
+						Percentage: &scheduledscalingv1.PercentageSpec{Value: 50},
+					},
+				},
+			},
+			ConflictPolicy: "minReplicas",
+		},
+		"UnknownDefaultMode": {
+			DefaultMode: "original",
+		},
+		"UnknownMode": {
+			ScaleRules: []scheduledscalingv1.ScaleRule{
+				{
+					Daily: validRule.Daily,
+					Mode:  "atleast",
+				},
+			},
+		},
+		"NegativeScaleDownDelay": {
+			ScaleDownDelay: "-1m",
+		},
+		"ZeroRampUpMaxStep": {
+			RampUp: &scheduledscalingv1.RampPolicy{MaxStep: 0, Interval: "1m"},
+		},
+		"InvalidRampDownInterval": {
+			ScaleRules: []scheduledscalingv1.ScaleRule{
+				{
+					Daily:    validRule.Daily,
+					RampDown: &scheduledscalingv1.RampPolicy{MaxStep: 1, Interval: "1"},
+				},
+			},
+		},
+		"NameWithSelectors": {
+			ScaleTarget: scheduledscalingv1.ScaleTarget{
+				Name:      "server1",
+				Selectors: map[string]string{"app": "server1"},
+			},
+		},
+	}
+	for name, spec := range invalidSpecs {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseSpec(spec)
+			if err == nil {
+				t.Fatalf("ParseSpec wants error but nil")
+			}
+			t.Logf("expected error: %s", err)
+		})
+	}
+}
+
+func TestRepository_UpdateStatus(t *testing.T) {
+	t.Run("HorizontalPodAutoscalerWithInvalidSpec", func(t *testing.T) {
+		ctx := context.TODO()
+		scheme := runtime.NewScheme()
+		if err := scheduledscalingv1.AddToScheme(scheme); err != nil {
+			t.Fatalf("AddToScheme error: %+v", err)
+		}
+		objectMeta := metav1.ObjectMeta{Namespace: "fixture", Name: "example1"}
+		c := fake.NewFakeClientWithScheme(scheme, &scheduledscalingv1.ScheduledPodScaler{ObjectMeta: objectMeta})
+		r := &Repository{Client: c}
+
+		// the spec is empty because it is invalid
+		s := &scheduledpodscaler.ScheduledPodScaler{
+			ObjectMeta: objectMeta,
+			Status: scheduledpodscaler.Status{
+				Targets: []scheduledpodscaler.TargetStatus{
+					{
+						Namespace: "fixture",
+						Name:      "server1",
+						Current:   scheduledpodscaler.ScaleSpec{MinReplicas: pointer.Int32Ptr(1), MaxReplicas: pointer.Int32Ptr(10)},
+						Desired:   scheduledpodscaler.ScaleSpec{MinReplicas: pointer.Int32Ptr(5)},
+					},
+				},
+			},
+		}
+		if err := r.UpdateStatus(ctx, s); err != nil {
+			t.Fatalf("UpdateStatus error: %+v", err)
+		}
+		var got scheduledscalingv1.ScheduledPodScaler
+		if err := c.Get(ctx, types.NamespacedName{Namespace: "fixture", Name: "example1"}, &got); err != nil {
+			t.Fatalf("Get error: %+v", err)
+		}
+		want := []scheduledscalingv1.ScheduledPodScalerTargetStatus{
+			{
+				Namespace:          "fixture",
+				Name:               "server1",
+				MinReplicas:        pointer.Int32Ptr(1),
+				MaxReplicas:        pointer.Int32Ptr(10),
+				DesiredMinReplicas: pointer.Int32Ptr(5),
+			},
+		}
+		if diff := cmp.Diff(want, got.Status.Targets); diff != "" {
+			t.Errorf("targets mismatch (-want, +got):\n%s", diff)
+		}
+	})
+}
@@ -0,0 +1,110 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/int128/scheduled-scaler/pkg/repositories/workload (interfaces: Interface)
+
+// Package mock_workload is a generated GoMock package.
+package mock_workload
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	workload "github.com/int128/scheduled-scaler/pkg/domain/workload"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	reflect "reflect"
+)
+
+// MockInterface is a mock of Interface interface
+type MockInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockInterfaceMockRecorder
+}
+
+// MockInterfaceMockRecorder is the mock recorder for MockInterface
+type MockInterfaceMockRecorder struct {
+	mock *MockInterface
+}
+
+// NewMockInterface creates a new mock instance
+func NewMockInterface(ctrl *gomock.Controller) *MockInterface {
+	mock := &MockInterface{ctrl: ctrl}
+	mock.recorder = &MockInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockInterface) EXPECT() *MockInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Annotate mocks base method
+func (m *MockInterface) Annotate(arg0 context.Context, arg1 *workload.Workload, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Annotate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Annotate indicates an expected call of Annotate
+func (mr *MockInterfaceMockRecorder) Annotate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Annotate", reflect.TypeOf((*MockInterface)(nil).Annotate), arg0, arg1, arg2, arg3)
+}
+
+// FindBySelectors mocks base method
+func (m *MockInterface) FindBySelectors(arg0 context.Context, arg1 string, arg2 schema.GroupVersionKind, arg3 labels.Selector) ([]workload.Workload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySelectors", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]workload.Workload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySelectors indicates an expected call of FindBySelectors
+func (mr *MockInterfaceMockRecorder) FindBySelectors(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySelectors", reflect.TypeOf((*MockInterface)(nil).FindBySelectors), arg0, arg1, arg2, arg3)
+}
+
+// GetByName mocks base method
+func (m *MockInterface) GetByName(arg0 context.Context, arg1 schema.GroupVersionKind, arg2 types.NamespacedName) (*workload.Workload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByName", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*workload.Workload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByName indicates an expected call of GetByName
+func (mr *MockInterfaceMockRecorder) GetByName(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByName", reflect.TypeOf((*MockInterface)(nil).GetByName), arg0, arg1, arg2)
+}
+
+// RemoveAnnotation mocks base method
+func (m *MockInterface) RemoveAnnotation(arg0 context.Context, arg1 *workload.Workload, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAnnotation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveAnnotation indicates an expected call of RemoveAnnotation
+func (mr *MockInterfaceMockRecorder) RemoveAnnotation(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAnnotation", reflect.TypeOf((*MockInterface)(nil).RemoveAnnotation), arg0, arg1, arg2)
+}
+
+// Scale mocks base method
+func (m *MockInterface) Scale(arg0 context.Context, arg1 *workload.Workload, arg2 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Scale", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Scale indicates an expected call of Scale
+func (mr *MockInterfaceMockRecorder) Scale(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scale", reflect.TypeOf((*MockInterface)(nil).Scale), arg0, arg1, arg2)
+}
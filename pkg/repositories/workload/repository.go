@@ -0,0 +1,179 @@
+package workload
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/wire"
+	"github.com/int128/scheduled-scaler/pkg/domain/workload"
+	"github.com/int128/scheduled-scaler/pkg/infrastructure/errors"
+	"golang.org/x/xerrors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/scale"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var Set = wire.NewSet(
+	wire.Bind(new(Interface), new(*Repository)),
+	wire.Struct(new(Repository), "*"),
+)
+
+//go:generate mockgen -destination mock_workload/mock_workload.go github.com/int128/scheduled-scaler/pkg/repositories/workload Interface
+
+type Interface interface {
+	GetByName(ctx context.Context, gvk schema.GroupVersionKind, name types.NamespacedName) (*workload.Workload, error)
+	FindBySelectors(ctx context.Context, namespace string, gvk schema.GroupVersionKind, selector labels.Selector) ([]workload.Workload, error)
+	Scale(ctx context.Context, w *workload.Workload, replicas int32) error
+	Annotate(ctx context.Context, w *workload.Workload, key, value string) error
+	RemoveAnnotation(ctx context.Context, w *workload.Workload, key string) error
+}
+
+type Repository struct {
+	Client      client.Client
+	RESTMapper  meta.RESTMapper
+	ScaleClient scale.ScalesGetter
+}
+
+// GetByName returns the resource of the kind and name.
+// It gets the replicas of the resource via the scale subresource.
+func (r *Repository) GetByName(ctx context.Context, gvk schema.GroupVersionKind, name types.NamespacedName) (*workload.Workload, error) {
+	mapping, err := r.RESTMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, xerrors.Errorf("could not find the resource of %s: %w", gvk, errors.WrapRESTMapping(err))
+	}
+	var o unstructured.Unstructured
+	o.SetGroupVersionKind(gvk)
+	if err := r.Client.Get(ctx, name, &o); err != nil {
+		return nil, errors.Wrap(err)
+	}
+	w, err := r.newWorkload(gvk, mapping, o)
+	if err != nil {
+		return nil, xerrors.Errorf("could not get the workload: %w", err)
+	}
+	return w, nil
+}
+
+// FindBySelectors returns a list of resources of the kind matched to the selector in the namespace.
+// It gets the replicas of each resource via the scale subresource.
+func (r *Repository) FindBySelectors(ctx context.Context, namespace string, gvk schema.GroupVersionKind, selector labels.Selector) ([]workload.Workload, error) {
+	mapping, err := r.RESTMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, xerrors.Errorf("could not find the resource of %s: %w", gvk, errors.WrapRESTMapping(err))
+	}
+	var l unstructured.UnstructuredList
+	l.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err := r.Client.List(ctx, &l, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, errors.Wrap(err)
+	}
+	var workloads []workload.Workload
+	for _, item := range l.Items {
+		w, err := r.newWorkload(gvk, mapping, item)
+		if err != nil {
+			return nil, xerrors.Errorf("could not get the workload: %w", err)
+		}
+		workloads = append(workloads, *w)
+	}
+	return workloads, nil
+}
+
+func (r *Repository) newWorkload(gvk schema.GroupVersionKind, mapping *meta.RESTMapping, o unstructured.Unstructured) (*workload.Workload, error) {
+	var m metav1.PartialObjectMetadata
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.UnstructuredContent(), &m); err != nil {
+		return nil, xerrors.Errorf("could not decode the metadata: %w", err)
+	}
+	s, err := r.ScaleClient.Scales(m.Namespace).Get(mapping.Resource.GroupResource(), m.Name)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return &workload.Workload{
+		TypeMeta:   metav1.TypeMeta{APIVersion: gvk.GroupVersion().String(), Kind: gvk.Kind},
+		ObjectMeta: m.ObjectMeta,
+		Replicas:   s.Spec.Replicas,
+	}, nil
+}
+
+// Scale updates the replicas of the resource to the given value using the patch method.
+func (r *Repository) Scale(ctx context.Context, w *workload.Workload, replicas int32) error {
+	gvk := w.TypeMeta.GroupVersionKind()
+	mapping, err := r.RESTMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return xerrors.Errorf("could not find the resource of %s: %w", gvk, errors.WrapRESTMapping(err))
+	}
+	b, err := json.Marshal(&scaleMergePatch{
+		Spec: scaleMergePatchSpec{
+			Replicas: replicas,
+		},
+	})
+	if err != nil {
+		return xerrors.Errorf("could not encode the json: %w", err)
+	}
+	if _, err := r.ScaleClient.Scales(w.ObjectMeta.Namespace).Patch(mapping.Resource, w.ObjectMeta.Name, types.MergePatchType, b); err != nil {
+		return errors.Wrap(err)
+	}
+	w.Replicas = replicas
+	return nil
+}
+
+// Annotate sets the annotation to the resource using the patch method.
+func (r *Repository) Annotate(ctx context.Context, w *workload.Workload, key, value string) error {
+	if err := r.patchAnnotation(ctx, w, key, &value); err != nil {
+		return xerrors.Errorf("could not set the annotation: %w", err)
+	}
+	if w.ObjectMeta.Annotations == nil {
+		w.ObjectMeta.Annotations = make(map[string]string)
+	}
+	w.ObjectMeta.Annotations[key] = value
+	return nil
+}
+
+// RemoveAnnotation removes the annotation from the resource using the patch method.
+func (r *Repository) RemoveAnnotation(ctx context.Context, w *workload.Workload, key string) error {
+	if err := r.patchAnnotation(ctx, w, key, nil); err != nil {
+		return xerrors.Errorf("could not remove the annotation: %w", err)
+	}
+	delete(w.ObjectMeta.Annotations, key)
+	return nil
+}
+
+// patchAnnotation sets the annotation to the value, or removes it if the value is nil.
+func (r *Repository) patchAnnotation(ctx context.Context, w *workload.Workload, key string, value *string) error {
+	var o unstructured.Unstructured
+	o.SetGroupVersionKind(w.TypeMeta.GroupVersionKind())
+	o.SetNamespace(w.ObjectMeta.Namespace)
+	o.SetName(w.ObjectMeta.Name)
+	b, err := json.Marshal(&metadataMergePatch{
+		Metadata: metadataMergePatchMetadata{
+			Annotations: map[string]*string{key: value},
+		},
+	})
+	if err != nil {
+		return xerrors.Errorf("could not encode the json: %w", err)
+	}
+	if err := r.Client.Patch(ctx, &o, client.ConstantPatch(types.MergePatchType, b)); err != nil {
+		return errors.Wrap(err)
+	}
+	return nil
+}
+
+type metadataMergePatch struct {
+	Metadata metadataMergePatchMetadata `json:"metadata"`
+}
+
+type metadataMergePatchMetadata struct {
+	// a nil value removes the annotation
+	Annotations map[string]*string `json:"annotations"`
+}
+
+type scaleMergePatch struct {
+	Spec scaleMergePatchSpec `json:"spec"`
+}
+
+type scaleMergePatchSpec struct {
+	Replicas int32 `json:"replicas"`
+}
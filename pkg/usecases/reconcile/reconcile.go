@@ -3,16 +3,26 @@ package reconcile
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/google/wire"
 	"github.com/int128/scheduled-scaler/pkg/domain/errors"
+	"github.com/int128/scheduled-scaler/pkg/domain/scheduledpodscaler"
+	"github.com/int128/scheduled-scaler/pkg/domain/workload"
 	"github.com/int128/scheduled-scaler/pkg/infrastructure/clock"
-	"github.com/int128/scheduled-scaler/pkg/repositories/deployment"
-	"github.com/int128/scheduled-scaler/pkg/repositories/scheduledpodscaler"
+	"github.com/int128/scheduled-scaler/pkg/infrastructure/metrics"
+	"github.com/int128/scheduled-scaler/pkg/repositories/horizontalpodautoscaler"
+	"github.com/int128/scheduled-scaler/pkg/repositories/namespace"
+	scheduledpodscalerrepository "github.com/int128/scheduled-scaler/pkg/repositories/scheduledpodscaler"
+	workloadrepository "github.com/int128/scheduled-scaler/pkg/repositories/workload"
 	"golang.org/x/xerrors"
+	kautoscaling "k8s.io/api/autoscaling/v1"
+	kcore "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/pointer"
 )
 
@@ -26,10 +36,21 @@ type Interface interface {
 }
 
 type Reconcile struct {
-	Log                          logr.Logger
-	Clock                        clock.Interface
-	ScheduledPodScalerRepository scheduledpodscaler.Interface
-	DeploymentRepository         deployment.Interface
+	Log                               logr.Logger
+	Clock                             clock.Interface
+	Recorder                          record.EventRecorder
+	Metrics                           metrics.Interface
+	Options                           Options
+	ScheduledPodScalerRepository      scheduledpodscalerrepository.Interface
+	WorkloadRepository                workloadrepository.Interface
+	HorizontalPodAutoscalerRepository horizontalpodautoscaler.Interface
+	NamespaceRepository               namespace.Interface
+}
+
+// Options represents the options of the controller.
+type Options struct {
+	// DryRun prevents scaling of all targets regardless of the spec.
+	DryRun bool
 }
 
 type Input struct {
@@ -45,34 +66,667 @@ func (r *Reconcile) Do(ctx context.Context, in Input) (*Output, error) {
 	if err != nil {
 		if errors.IsNotFound(err) {
 			r.Log.Info("the ScheduledPodScaler has already removed and ended up", "error", err)
+			r.Metrics.DeleteScheduledPodScaler(in.Target)
 			return &Output{NextReconcileAfter: 0}, nil
 		}
+		if errors.IsInvalid(err) && scheduledPodScaler != nil {
+			return r.invalidate(ctx, scheduledPodScaler, err)
+		}
 		return nil, xerrors.Errorf("could not get the ScheduledPodScaler: %w", err)
 	}
 
-	selectors := scheduledPodScaler.Spec.ScaleTarget.Selectors
-	deploymentList, err := r.DeploymentRepository.FindBySelectors(ctx, selectors)
-	if err != nil {
-		return nil, xerrors.Errorf("could not find the deployments: %w", err)
-	}
-	r.Log.Info(fmt.Sprintf("found %d deployments", len(deploymentList.Items)), "selectors", selectors)
-
 	now := r.Clock.Now()
+	scheduledPodScaler.Status.SetCondition(scheduledpodscaler.Condition{
+		Type:               scheduledpodscaler.ConditionScheduleValid,
+		Status:             kcore.ConditionTrue,
+		LastTransitionTime: now,
+		Reason:             "Valid",
+	})
+	if scheduledPodScaler.Spec.IsSuspended(now) {
+		return r.suspend(ctx, scheduledPodScaler, now)
+	}
+	scheduledPodScaler.Status.SetCondition(scheduledpodscaler.Condition{
+		Type:               scheduledpodscaler.ConditionSuspended,
+		Status:             kcore.ConditionFalse,
+		LastTransitionTime: now,
+		Reason:             "NotSuspended",
+	})
+	activeRuleIndex := scheduledPodScaler.Spec.FindActiveRuleIndex(now)
+	activeRule := scheduledPodScaler.Spec.RuleName(activeRuleIndex)
 	desiredScaleSpec := scheduledPodScaler.Spec.ComputeDesiredScaleSpec(now)
-	for _, deploymentItem := range deploymentList.Items {
-		currentReplicas := pointer.Int32PtrDerefOr(deploymentItem.Spec.Replicas, 0)
-		r.Log.Info("comparing the replicas", "current", currentReplicas, "desired", desiredScaleSpec.Replicas)
-		if currentReplicas != desiredScaleSpec.Replicas {
-			r.Log.Info("applying the patch to the deployment", "replicas", currentReplicas)
-			if err := r.DeploymentRepository.Scale(ctx, &deploymentItem, desiredScaleSpec.Replicas); err != nil {
-				return nil, xerrors.Errorf("could not scale the deployment: %w", err)
-			}
-		}
+	r.Log.Info("computed the desired state", "activeRule", activeRule)
+	scheduledPodScaler.Status.ActiveRule = activeRule
+	scheduledPodScaler.Status.DesiredScaleSpec = &desiredScaleSpec
+	dryRun := r.Options.DryRun || scheduledPodScaler.Spec.DryRun
+	if dryRun {
+		r.Log.Info("dry-run is enabled and the targets will not be scaled")
+	}
+	behavior := scheduledPodScaler.Spec.FindBehavior(activeRuleIndex)
+	if behavior.RestoreOriginal {
+		r.Log.Info("no rule is active and the targets will be restored to the original replicas")
+		// the desired replicas depends on each target
+		scheduledPodScaler.Status.DesiredScaleSpec = nil
 	}
+	scaleErr := r.scale(ctx, scheduledPodScaler, desiredScaleSpec, behavior, dryRun, now)
+	scheduledPodScaler.Status.SetReadyCondition(now)
 
 	scheduledPodScaler.Status.NextReconcileTime = scheduledPodScaler.Spec.FindNextReconcileTime(now)
+	if nextTargetTime := scheduledPodScaler.Status.FindNextTargetTime(now); !nextTargetTime.IsZero() {
+		if scheduledPodScaler.Status.NextReconcileTime.IsZero() || nextTargetTime.Before(scheduledPodScaler.Status.NextReconcileTime) {
+			r.Log.Info("the targets will be scaled later", "nextTargetTime", nextTargetTime)
+			scheduledPodScaler.Status.NextReconcileTime = nextTargetTime
+		}
+	}
+	if err := r.ScheduledPodScalerRepository.UpdateStatus(ctx, scheduledPodScaler); err != nil {
+		return nil, xerrors.Errorf("could not update the status of ScheduledPodScaler: %w", err)
+	}
+	if scaleErr != nil {
+		return nil, xerrors.Errorf("could not scale the targets: %w", scaleErr)
+	}
+	if scheduledPodScaler.Status.NextReconcileTime.IsZero() {
+		r.Log.Info("no rule will start or end")
+		r.Metrics.ObserveNextReconcile(in.Target, 0)
+		return &Output{NextReconcileAfter: 0}, nil
+	}
+	nextReconcileAfter := scheduledPodScaler.Status.NextReconcileTime.Sub(now)
+	r.Metrics.ObserveNextReconcile(in.Target, nextReconcileAfter)
+	return &Output{NextReconcileAfter: nextReconcileAfter}, nil
+}
+
+// invalidate updates the conditions of the ScheduledPodScaler which has an invalid spec.
+// It does not requeue because the ScheduledPodScaler will be reconciled when the spec is fixed.
+func (r *Reconcile) invalidate(ctx context.Context, scheduledPodScaler *scheduledpodscaler.ScheduledPodScaler, specErr error) (*Output, error) {
+	r.Log.Info("the ScheduledPodScaler has an invalid spec", "error", specErr)
+	r.Recorder.Event(scheduledPodScalerReference(scheduledPodScaler), kcore.EventTypeWarning, "InvalidSpec", specErr.Error())
+	now := r.Clock.Now()
+	scheduledPodScaler.Status.SetCondition(scheduledpodscaler.Condition{
+		Type:               scheduledpodscaler.ConditionScheduleValid,
+		Status:             kcore.ConditionFalse,
+		LastTransitionTime: now,
+		Reason:             "InvalidSpec",
+		Message:            specErr.Error(),
+	})
+	scheduledPodScaler.Status.SetReadyCondition(now)
+	scheduledPodScaler.Status.NextReconcileTime = time.Time{}
+	r.Metrics.ObserveNextReconcile(scheduledPodScalerName(scheduledPodScaler), 0)
+	scheduledPodScaler.Status.ActiveRule = ""
+	scheduledPodScaler.Status.DesiredScaleSpec = nil
 	if err := r.ScheduledPodScalerRepository.UpdateStatus(ctx, scheduledPodScaler); err != nil {
 		return nil, xerrors.Errorf("could not update the status of ScheduledPodScaler: %w", err)
 	}
-	return &Output{NextReconcileAfter: scheduledPodScaler.Status.NextReconcileTime.Sub(now)}, nil
+	return &Output{NextReconcileAfter: 0}, nil
+}
+
+// suspend updates the conditions of the ScheduledPodScaler without scaling the targets.
+// It keeps the other status to show the state when it has been suspended.
+// It requeues at SuspendUntil if it is set, otherwise it does not requeue until the spec is changed.
+func (r *Reconcile) suspend(ctx context.Context, scheduledPodScaler *scheduledpodscaler.ScheduledPodScaler, now time.Time) (*Output, error) {
+	suspendUntil := scheduledPodScaler.Spec.SuspendUntil
+	message := "suspended until spec.suspend is unset"
+	if !suspendUntil.IsZero() {
+		message = fmt.Sprintf("suspended until %s", suspendUntil.Format(time.RFC3339))
+	}
+	r.Log.Info("the ScheduledPodScaler is suspended", "suspendUntil", suspendUntil)
+	scheduledPodScaler.Status.SetCondition(scheduledpodscaler.Condition{
+		Type:               scheduledpodscaler.ConditionSuspended,
+		Status:             kcore.ConditionTrue,
+		LastTransitionTime: now,
+		Reason:             "Suspended",
+		Message:            message,
+	})
+	scheduledPodScaler.Status.SetReadyCondition(now)
+	scheduledPodScaler.Status.NextReconcileTime = suspendUntil
+	if err := r.ScheduledPodScalerRepository.UpdateStatus(ctx, scheduledPodScaler); err != nil {
+		return nil, xerrors.Errorf("could not update the status of ScheduledPodScaler: %w", err)
+	}
+	if suspendUntil.IsZero() {
+		r.Metrics.ObserveNextReconcile(scheduledPodScalerName(scheduledPodScaler), 0)
+		return &Output{NextReconcileAfter: 0}, nil
+	}
+	nextReconcileAfter := suspendUntil.Sub(now)
+	r.Metrics.ObserveNextReconcile(scheduledPodScalerName(scheduledPodScaler), nextReconcileAfter)
+	return &Output{NextReconcileAfter: nextReconcileAfter}, nil
+}
+
+// scale finds the targets and scales them to the desired replicas.
+// It sets the TargetsFound and Scaled conditions, Targets and LastScaleTime.
+// If dryRun is true, it only reports the targets to be scaled.
+func (r *Reconcile) scale(ctx context.Context, scheduledPodScaler *scheduledpodscaler.ScheduledPodScaler, desiredScaleSpec scheduledpodscaler.ScaleSpec, behavior scheduledpodscaler.Behavior, dryRun bool, now time.Time) error {
+	target := scheduledPodScaler.Spec.ScaleTarget
+	namespaces, err := r.findNamespaces(ctx, scheduledPodScaler)
+	if err != nil {
+		scheduledPodScaler.Status.SetCondition(targetsNotFoundCondition("FindFailed", err.Error(), now))
+		scheduledPodScaler.Status.SetCondition(notScaledCondition("FindFailed", err.Error(), now))
+		return xerrors.Errorf("could not find the namespaces: %w", err)
+	}
+
+	if target.IsHorizontalPodAutoscaler() {
+		hpas, err := r.findHorizontalPodAutoscalers(ctx, namespaces, target)
+		if err != nil {
+			scheduledPodScaler.Status.SetCondition(targetsNotFoundCondition("FindFailed", err.Error(), now))
+			scheduledPodScaler.Status.SetCondition(notScaledCondition("FindFailed", err.Error(), now))
+			return xerrors.Errorf("could not find the HorizontalPodAutoscalers: %w", err)
+		}
+		scheduledPodScaler.Status.SetCondition(targetsFoundCondition(target, len(hpas), now))
+		targets, err := r.scaleHorizontalPodAutoscalers(ctx, scheduledPodScaler, hpas, desiredScaleSpec, dryRun, now)
+		// a HorizontalPodAutoscaler has no replicas metrics
+		r.deleteTargetMetrics(scheduledPodScaler, nil)
+		setTargets(&scheduledPodScaler.Status, targets, now)
+		if err != nil {
+			scheduledPodScaler.Status.SetCondition(notScaledCondition("ScaleFailed", err.Error(), now))
+			return xerrors.Errorf("could not scale the HorizontalPodAutoscalers: %w", err)
+		}
+		scheduledPodScaler.Status.SetCondition(scaledCondition(target, targets, dryRun, now))
+		return nil
+	}
+
+	workloads, err := r.findWorkloads(ctx, namespaces, target)
+	if errors.IsUnknownKind(err) {
+		// retrying does not help until the kind is fixed or the CRD is installed
+		r.Log.Info("the kind of the target is unknown", "error", err)
+		scheduledPodScaler.Status.SetCondition(targetsNotFoundCondition("UnknownKind", err.Error(), now))
+		scheduledPodScaler.Status.SetCondition(notScaledCondition("UnknownKind", err.Error(), now))
+		return nil
+	}
+	if err != nil {
+		scheduledPodScaler.Status.SetCondition(targetsNotFoundCondition("FindFailed", err.Error(), now))
+		scheduledPodScaler.Status.SetCondition(notScaledCondition("FindFailed", err.Error(), now))
+		return xerrors.Errorf("could not find the %s: %w", target.GroupVersionKind.Kind, err)
+	}
+	scheduledPodScaler.Status.SetCondition(targetsFoundCondition(target, len(workloads), now))
+	targets, err := r.scaleWorkloads(ctx, scheduledPodScaler, workloads, desiredScaleSpec, behavior, dryRun, now)
+	r.deleteTargetMetrics(scheduledPodScaler, targets)
+	setTargets(&scheduledPodScaler.Status, targets, now)
+	if err != nil {
+		scheduledPodScaler.Status.SetCondition(notScaledCondition("ScaleFailed", err.Error(), now))
+		return xerrors.Errorf("could not scale the %s: %w", target.GroupVersionKind.Kind, err)
+	}
+	scheduledPodScaler.Status.SetCondition(scaledCondition(target, targets, dryRun, now))
+	return nil
+}
+
+// findNamespaces returns the namespaces to find the targets.
+// It returns the namespace of the ScheduledPodScaler unless the NamespaceSelector is set.
+func (r *Reconcile) findNamespaces(ctx context.Context, scheduledPodScaler *scheduledpodscaler.ScheduledPodScaler) ([]string, error) {
+	selector := scheduledPodScaler.Spec.ScaleTarget.NamespaceSelector
+	if selector == nil {
+		return []string{scheduledPodScaler.ObjectMeta.Namespace}, nil
+	}
+	namespaceList, err := r.NamespaceRepository.FindBySelector(ctx, selector)
+	if err != nil {
+		return nil, xerrors.Errorf("could not find the namespaces: %w", err)
+	}
+	var namespaces []string
+	for _, item := range namespaceList.Items {
+		namespaces = append(namespaces, item.Name)
+	}
+	r.Log.Info(fmt.Sprintf("found %d namespaces", len(namespaces)), "namespaceSelector", selector.String())
+	return namespaces, nil
+}
+
+// findWorkloads returns the workloads of the name or matched to the selector in the namespaces.
+func (r *Reconcile) findWorkloads(ctx context.Context, namespaces []string, target scheduledpodscaler.ScaleTarget) ([]workload.Workload, error) {
+	var workloads []workload.Workload
+	for _, ns := range namespaces {
+		if target.Name != "" {
+			w, err := r.WorkloadRepository.GetByName(ctx, target.GroupVersionKind, types.NamespacedName{Namespace: ns, Name: target.Name})
+			if err != nil {
+				if errors.IsNotFound(err) {
+					r.Log.Info("the target is not found", "namespace", ns, "name", target.Name, "error", err)
+					continue
+				}
+				return nil, xerrors.Errorf("could not get the %s in namespace %s: %w", target.GroupVersionKind.Kind, ns, err)
+			}
+			workloads = append(workloads, *w)
+			continue
+		}
+		items, err := r.WorkloadRepository.FindBySelectors(ctx, ns, target.GroupVersionKind, target.Selector)
+		if err != nil {
+			return nil, xerrors.Errorf("could not find the %s in namespace %s: %w", target.GroupVersionKind.Kind, ns, err)
+		}
+		workloads = append(workloads, items...)
+	}
+	r.Log.Info(fmt.Sprintf("found %d %s", len(workloads), target.GroupVersionKind.Kind), "target", describeTarget(target))
+	return workloads, nil
+}
+
+// ignoredMessage is the reason of a target skipped by the ignore annotation.
+var ignoredMessage = fmt.Sprintf("ignored by the annotation %s", scheduledpodscaler.IgnoreAnnotation)
+
+// scaleWorkloads scales the workloads to the desired replicas.
+// It continues even if an error occurred and returns the first error.
+// It returns the status of the workloads, which carries over LastScaleTime from the previous status.
+// It records an event to the ScheduledPodScaler and workload when the replicas is changed or failed.
+// The desired replicas of each target is determined by the percentage of the baseline and the mode of the behavior,
+// or the original replicas if the behavior is to restore them.
+// If an annotation of the replicas is invalid, it skips the target and records an event.
+// If the scale-down delay is set, it waits for the delay before decreasing the replicas,
+// and clears the scale-down time when the target reaches the desired replicas.
+// If the ramp policy is set, it changes the replicas by a step and sets the next step time of the target.
+// If dryRun is true, it records an event of the change instead of scaling.
+func (r *Reconcile) scaleWorkloads(ctx context.Context, scheduledPodScaler *scheduledpodscaler.ScheduledPodScaler, workloads []workload.Workload, desiredScaleSpec scheduledpodscaler.ScaleSpec, behavior scheduledpodscaler.Behavior, dryRun bool, now time.Time) ([]scheduledpodscaler.TargetStatus, error) {
+	var targets []scheduledpodscaler.TargetStatus
+	var errs []error
+	for i := range workloads {
+		w := &workloads[i]
+		ts := newTargetStatus(w.ObjectMeta.Namespace, w.ObjectMeta.Name, &scheduledPodScaler.Status)
+		ts.Current = scheduledpodscaler.ScaleSpec{Replicas: w.Replicas}
+		ignored := scheduledpodscaler.IsIgnored(w.ObjectMeta.Annotations)
+		desiredReplicas, err := r.computeDesiredReplicas(ctx, w, desiredScaleSpec, behavior, !ignored && !dryRun)
+		if errors.IsInvalid(err) {
+			// an annotation of the target is wrong, and retrying does not help
+			r.Log.Info("skipped the target due to the invalid annotation", "namespace", w.ObjectMeta.Namespace, "name", w.ObjectMeta.Name, "error", err)
+			ts.Skipped = err.Error()
+			r.recordEvent(scheduledPodScaler, workloadReference(w), kcore.EventTypeWarning, "InvalidAnnotation",
+				fmt.Sprintf("Skipped %s/%s: %s", w.ObjectMeta.Namespace, w.ObjectMeta.Name, err))
+			targets = append(targets, ts)
+			continue
+		}
+		if err != nil {
+			r.Log.Error(err, "could not compute the desired replicas", "namespace", w.ObjectMeta.Namespace, "name", w.ObjectMeta.Name)
+			ts.Error = err.Error()
+			errs = append(errs, xerrors.Errorf("could not compute the desired replicas of the %s %s/%s: %w", w.TypeMeta.Kind, w.ObjectMeta.Namespace, w.ObjectMeta.Name, err))
+			targets = append(targets, ts)
+			continue
+		}
+		desiredReplicas = behavior.Mode.Apply(w.Replicas, desiredReplicas)
+		ts.Desired = scheduledpodscaler.ScaleSpec{Replicas: desiredReplicas}
+		if ignored {
+			r.Log.Info("skipped the target due to the annotation", "namespace", w.ObjectMeta.Namespace, "name", w.ObjectMeta.Name, "annotation", scheduledpodscaler.IgnoreAnnotation)
+			ts.Skipped = ignoredMessage
+			targets = append(targets, ts)
+			continue
+		}
+		r.Log.Info("comparing the replicas", "namespace", w.ObjectMeta.Namespace, "name", w.ObjectMeta.Name, "current", w.Replicas, "desired", desiredReplicas, "mode", behavior.Mode)
+		r.Metrics.ObserveReplicas(scheduledPodScalerName(scheduledPodScaler),
+			types.NamespacedName{Namespace: w.ObjectMeta.Namespace, Name: w.ObjectMeta.Name}, w.Replicas, desiredReplicas)
+		ts.ScaleDownTime = scheduledpodscaler.ComputeScaleDownTime(w.Replicas, desiredReplicas, behavior.ScaleDownDelay, ts.ScaleDownTime, now)
+		if ts.ScaleDownTime.After(now) {
+			r.Log.Info("waiting for the scale-down delay", "namespace", w.ObjectMeta.Namespace, "name", w.ObjectMeta.Name, "scaleDownTime", ts.ScaleDownTime)
+			targets = append(targets, ts)
+			continue
+		}
+		replicas, nextStepTime := behavior.Ramp.ComputeStep(w.Replicas, desiredReplicas, ts.LastScaleTime, now)
+		change := describeReplicasChange(replicas, desiredReplicas)
+		if w.Replicas != replicas && dryRun {
+			r.Log.Info("skipped scaling due to dry-run", "namespace", w.ObjectMeta.Namespace, "name", w.ObjectMeta.Name)
+			r.recordEvent(scheduledPodScaler, workloadReference(w), kcore.EventTypeNormal, "DryRun",
+				fmt.Sprintf("Would scale %s/%s from %d to %s by rule %s", w.ObjectMeta.Namespace, w.ObjectMeta.Name, ts.Current.Replicas, change, scheduledPodScaler.Status.ActiveRule))
+		} else if w.Replicas != replicas {
+			r.Log.Info("applying the patch to the scale subresource", "namespace", w.ObjectMeta.Namespace, "name", w.ObjectMeta.Name, "replicas", replicas)
+			if err := r.WorkloadRepository.Scale(ctx, w, replicas); err != nil {
+				r.Log.Error(err, "could not scale the target", "namespace", w.ObjectMeta.Namespace, "name", w.ObjectMeta.Name)
+				ts.Error = err.Error()
+				r.Metrics.IncScaleOperation(scheduledPodScalerName(scheduledPodScaler), metrics.ScaleFailed)
+				errs = append(errs, xerrors.Errorf("could not scale the %s %s/%s: %w", w.TypeMeta.Kind, w.ObjectMeta.Namespace, w.ObjectMeta.Name, err))
+				r.recordEvent(scheduledPodScaler, workloadReference(w), kcore.EventTypeWarning, "ScaleFailed",
+					fmt.Sprintf("Could not scale %s/%s to %s: %s", w.ObjectMeta.Namespace, w.ObjectMeta.Name, change, err))
+			} else {
+				ts.LastScaleTime = now
+				ts.NextStepTime = nextStepTime
+				if replicas == desiredReplicas {
+					// the delay has been applied to this scale-down, and the next one needs a new delay
+					ts.ScaleDownTime = time.Time{}
+				}
+				r.Metrics.IncScaleOperation(scheduledPodScalerName(scheduledPodScaler), metrics.ScaleSucceeded)
+				r.recordEvent(scheduledPodScaler, workloadReference(w), kcore.EventTypeNormal, "Scaled",
+					fmt.Sprintf("Scaled %s/%s from %d to %s by rule %s", w.ObjectMeta.Namespace, w.ObjectMeta.Name, ts.Current.Replicas, change, scheduledPodScaler.Status.ActiveRule))
+			}
+		} else if !dryRun {
+			ts.NextStepTime = nextStepTime
+		}
+		if behavior.RestoreOriginal && !dryRun && w.Replicas == desiredReplicas {
+			if err := r.removeOriginalReplicas(ctx, w); err != nil {
+				r.Log.Error(err, "could not remove the original replicas", "namespace", w.ObjectMeta.Namespace, "name", w.ObjectMeta.Name)
+				ts.Error = err.Error()
+				errs = append(errs, xerrors.Errorf("could not remove the original replicas of the %s %s/%s: %w", w.TypeMeta.Kind, w.ObjectMeta.Namespace, w.ObjectMeta.Name, err))
+			}
+		}
+		targets = append(targets, ts)
+	}
+	if len(errs) > 0 {
+		return targets, xerrors.Errorf("could not scale %d of %d targets: %w", len(errs), len(targets), errs[0])
+	}
+	return targets, nil
+}
+
+// computeDesiredReplicas returns the desired replicas of the workload.
+// If the behavior is to restore, it returns the original replicas of the workload,
+// or the current replicas if the workload has no original replicas.
+// If the behavior is to record the original replicas, it records the current replicas to the annotation if record is true.
+// If the scale spec is a percentage, it computes the replicas from the baseline annotation of the workload.
+// If the workload has no baseline annotation, it uses the current replicas as the baseline
+// and records it to the annotation if record is true.
+func (r *Reconcile) computeDesiredReplicas(ctx context.Context, w *workload.Workload, desiredScaleSpec scheduledpodscaler.ScaleSpec, behavior scheduledpodscaler.Behavior, record bool) (int32, error) {
+	if behavior.RestoreOriginal {
+		original, ok, err := scheduledpodscaler.FindOriginalReplicas(w.ObjectMeta.Annotations)
+		if err != nil {
+			return 0, xerrors.Errorf("could not find the original replicas: %w", err)
+		}
+		if !ok {
+			return w.Replicas, nil
+		}
+		return original, nil
+	}
+	if behavior.RecordOriginal && record {
+		_, ok, err := scheduledpodscaler.FindOriginalReplicas(w.ObjectMeta.Annotations)
+		if err != nil {
+			return 0, xerrors.Errorf("could not find the original replicas: %w", err)
+		}
+		if !ok {
+			r.Log.Info("recording the original replicas", "namespace", w.ObjectMeta.Namespace, "name", w.ObjectMeta.Name, "original", w.Replicas)
+			if err := r.WorkloadRepository.Annotate(ctx, w, scheduledpodscaler.OriginalReplicasAnnotation, strconv.Itoa(int(w.Replicas))); err != nil {
+				return 0, xerrors.Errorf("could not record the original replicas: %w", err)
+			}
+		}
+	}
+	if desiredScaleSpec.Percentage == nil {
+		return desiredScaleSpec.Replicas, nil
+	}
+	baseline, ok, err := scheduledpodscaler.FindBaselineReplicas(w.ObjectMeta.Annotations)
+	if err != nil {
+		return 0, xerrors.Errorf("could not find the baseline replicas: %w", err)
+	}
+	if ok {
+		return desiredScaleSpec.ComputeReplicas(baseline), nil
+	}
+	baseline = w.Replicas
+	if record {
+		r.Log.Info("recording the baseline replicas", "namespace", w.ObjectMeta.Namespace, "name", w.ObjectMeta.Name, "baseline", baseline)
+		if err := r.WorkloadRepository.Annotate(ctx, w, scheduledpodscaler.BaselineReplicasAnnotation, strconv.Itoa(int(baseline))); err != nil {
+			return 0, xerrors.Errorf("could not record the baseline replicas: %w", err)
+		}
+	}
+	return desiredScaleSpec.ComputeReplicas(baseline), nil
+}
+
+// removeOriginalReplicas removes the original replicas from the workload if it exists.
+func (r *Reconcile) removeOriginalReplicas(ctx context.Context, w *workload.Workload) error {
+	if _, ok := w.ObjectMeta.Annotations[scheduledpodscaler.OriginalReplicasAnnotation]; !ok {
+		return nil
+	}
+	r.Log.Info("restored the original replicas", "namespace", w.ObjectMeta.Namespace, "name", w.ObjectMeta.Name, "replicas", w.Replicas)
+	if err := r.WorkloadRepository.RemoveAnnotation(ctx, w, scheduledpodscaler.OriginalReplicasAnnotation); err != nil {
+		return xerrors.Errorf("could not remove the annotation: %w", err)
+	}
+	return nil
+}
+
+// findHorizontalPodAutoscalers returns the HorizontalPodAutoscalers of the name or matched to the selector in the namespaces.
+func (r *Reconcile) findHorizontalPodAutoscalers(ctx context.Context, namespaces []string, target scheduledpodscaler.ScaleTarget) ([]kautoscaling.HorizontalPodAutoscaler, error) {
+	var hpas []kautoscaling.HorizontalPodAutoscaler
+	for _, ns := range namespaces {
+		if target.Name != "" {
+			hpa, err := r.HorizontalPodAutoscalerRepository.GetByName(ctx, types.NamespacedName{Namespace: ns, Name: target.Name})
+			if err != nil {
+				if errors.IsNotFound(err) {
+					r.Log.Info("the target is not found", "namespace", ns, "name", target.Name, "error", err)
+					continue
+				}
+				return nil, xerrors.Errorf("could not get the HorizontalPodAutoscaler in namespace %s: %w", ns, err)
+			}
+			hpas = append(hpas, *hpa)
+			continue
+		}
+		hpaList, err := r.HorizontalPodAutoscalerRepository.FindBySelectors(ctx, ns, target.Selector)
+		if err != nil {
+			return nil, xerrors.Errorf("could not find the HorizontalPodAutoscalers in namespace %s: %w", ns, err)
+		}
+		hpas = append(hpas, hpaList.Items...)
+	}
+	r.Log.Info(fmt.Sprintf("found %d HorizontalPodAutoscalers", len(hpas)), "target", describeTarget(target))
+	return hpas, nil
+}
+
+// scaleHorizontalPodAutoscalers scales the HorizontalPodAutoscalers to the desired replicas.
+// It continues even if an error occurred and returns the first error.
+// It returns the status of the HorizontalPodAutoscalers, which carries over LastScaleTime from the previous status.
+// It records an event to the ScheduledPodScaler and HorizontalPodAutoscaler when the replicas is changed or failed.
+// If dryRun is true, it records an event of the change instead of scaling.
+func (r *Reconcile) scaleHorizontalPodAutoscalers(ctx context.Context, scheduledPodScaler *scheduledpodscaler.ScheduledPodScaler, hpas []kautoscaling.HorizontalPodAutoscaler, desiredScaleSpec scheduledpodscaler.ScaleSpec, dryRun bool, now time.Time) ([]scheduledpodscaler.TargetStatus, error) {
+	var targets []scheduledpodscaler.TargetStatus
+	var errs []error
+	for i := range hpas {
+		hpa := &hpas[i]
+		ts := newTargetStatus(hpa.Namespace, hpa.Name, &scheduledPodScaler.Status)
+		currentMinReplicas := pointer.Int32PtrDerefOr(hpa.Spec.MinReplicas, 1)
+		currentMaxReplicas := hpa.Spec.MaxReplicas
+		ts.Current = scheduledpodscaler.ScaleSpec{MinReplicas: pointer.Int32Ptr(currentMinReplicas), MaxReplicas: pointer.Int32Ptr(currentMaxReplicas)}
+		ts.Desired = scheduledpodscaler.ScaleSpec{MinReplicas: desiredScaleSpec.MinReplicas, MaxReplicas: desiredScaleSpec.MaxReplicas}
+		if scheduledpodscaler.IsIgnored(hpa.Annotations) {
+			r.Log.Info("skipped the target due to the annotation", "namespace", hpa.Namespace, "name", hpa.Name, "annotation", scheduledpodscaler.IgnoreAnnotation)
+			ts.Skipped = ignoredMessage
+			targets = append(targets, ts)
+			continue
+		}
+		r.Log.Info("comparing the replicas", "namespace", hpa.Namespace, "name", hpa.Name,
+			"currentMin", currentMinReplicas, "desiredMin", desiredScaleSpec.MinReplicas,
+			"currentMax", currentMaxReplicas, "desiredMax", desiredScaleSpec.MaxReplicas)
+		var minReplicas, maxReplicas *int32
+		if desiredScaleSpec.MinReplicas != nil && *desiredScaleSpec.MinReplicas != currentMinReplicas {
+			minReplicas = desiredScaleSpec.MinReplicas
+		}
+		if desiredScaleSpec.MaxReplicas != nil && *desiredScaleSpec.MaxReplicas != currentMaxReplicas {
+			maxReplicas = desiredScaleSpec.MaxReplicas
+		}
+		if (minReplicas != nil || maxReplicas != nil) && dryRun {
+			r.Log.Info("skipped scaling due to dry-run", "namespace", hpa.Namespace, "name", hpa.Name)
+			change := describeHorizontalPodAutoscalerChange(currentMinReplicas, currentMaxReplicas, minReplicas, maxReplicas)
+			r.recordEvent(scheduledPodScaler, horizontalPodAutoscalerReference(hpa), kcore.EventTypeNormal, "DryRun",
+				fmt.Sprintf("Would scale %s/%s %s by rule %s", hpa.Namespace, hpa.Name, change, scheduledPodScaler.Status.ActiveRule))
+		} else if minReplicas != nil || maxReplicas != nil {
+			r.Log.Info("applying the patch to the HorizontalPodAutoscaler", "namespace", hpa.Namespace, "name", hpa.Name)
+			change := describeHorizontalPodAutoscalerChange(currentMinReplicas, currentMaxReplicas, minReplicas, maxReplicas)
+			if err := r.HorizontalPodAutoscalerRepository.Scale(ctx, hpa, minReplicas, maxReplicas); err != nil {
+				r.Log.Error(err, "could not scale the target", "namespace", hpa.Namespace, "name", hpa.Name)
+				ts.Error = err.Error()
+				r.Metrics.IncScaleOperation(scheduledPodScalerName(scheduledPodScaler), metrics.ScaleFailed)
+				errs = append(errs, xerrors.Errorf("could not scale the HorizontalPodAutoscaler %s/%s: %w", hpa.Namespace, hpa.Name, err))
+				r.recordEvent(scheduledPodScaler, horizontalPodAutoscalerReference(hpa), kcore.EventTypeWarning, "ScaleFailed",
+					fmt.Sprintf("Could not scale %s/%s %s: %s", hpa.Namespace, hpa.Name, change, err))
+			} else {
+				ts.LastScaleTime = now
+				r.Metrics.IncScaleOperation(scheduledPodScalerName(scheduledPodScaler), metrics.ScaleSucceeded)
+				r.recordEvent(scheduledPodScaler, horizontalPodAutoscalerReference(hpa), kcore.EventTypeNormal, "Scaled",
+					fmt.Sprintf("Scaled %s/%s %s by rule %s", hpa.Namespace, hpa.Name, change, scheduledPodScaler.Status.ActiveRule))
+			}
+		}
+		targets = append(targets, ts)
+	}
+	if len(errs) > 0 {
+		return targets, xerrors.Errorf("could not scale %d of %d targets: %w", len(errs), len(targets), errs[0])
+	}
+	return targets, nil
+}
+
+// describeReplicasChange returns a description of the replicas, e.g. 5 or 5 toward 10 while ramping.
+func describeReplicasChange(replicas, desiredReplicas int32) string {
+	if replicas == desiredReplicas {
+		return fmt.Sprintf("%d", replicas)
+	}
+	return fmt.Sprintf("%d toward %d", replicas, desiredReplicas)
+}
+
+// describeHorizontalPodAutoscalerChange returns a description of the change, e.g. minReplicas from 1 to 5.
+func describeHorizontalPodAutoscalerChange(currentMinReplicas, currentMaxReplicas int32, minReplicas, maxReplicas *int32) string {
+	var changes []string
+	if minReplicas != nil {
+		changes = append(changes, fmt.Sprintf("minReplicas from %d to %d", currentMinReplicas, *minReplicas))
+	}
+	if maxReplicas != nil {
+		changes = append(changes, fmt.Sprintf("maxReplicas from %d to %d", currentMaxReplicas, *maxReplicas))
+	}
+	return strings.Join(changes, " and ")
+}
+
+// recordEvent records the event to both the ScheduledPodScaler and target.
+func (r *Reconcile) recordEvent(scheduledPodScaler *scheduledpodscaler.ScheduledPodScaler, target *kcore.ObjectReference, eventType, reason, message string) {
+	r.Recorder.Event(scheduledPodScalerReference(scheduledPodScaler), eventType, reason, message)
+	r.Recorder.Event(target, eventType, reason, message)
+}
+
+func scheduledPodScalerName(s *scheduledpodscaler.ScheduledPodScaler) types.NamespacedName {
+	return types.NamespacedName{Namespace: s.ObjectMeta.Namespace, Name: s.ObjectMeta.Name}
+}
+
+func scheduledPodScalerReference(s *scheduledpodscaler.ScheduledPodScaler) *kcore.ObjectReference {
+	return &kcore.ObjectReference{
+		APIVersion:      s.TypeMeta.APIVersion,
+		Kind:            s.TypeMeta.Kind,
+		Namespace:       s.ObjectMeta.Namespace,
+		Name:            s.ObjectMeta.Name,
+		UID:             s.ObjectMeta.UID,
+		ResourceVersion: s.ObjectMeta.ResourceVersion,
+	}
+}
+
+func workloadReference(w *workload.Workload) *kcore.ObjectReference {
+	return &kcore.ObjectReference{
+		APIVersion: w.TypeMeta.APIVersion,
+		Kind:       w.TypeMeta.Kind,
+		Namespace:  w.ObjectMeta.Namespace,
+		Name:       w.ObjectMeta.Name,
+		UID:        w.ObjectMeta.UID,
+	}
+}
+
+func horizontalPodAutoscalerReference(hpa *kautoscaling.HorizontalPodAutoscaler) *kcore.ObjectReference {
+	return &kcore.ObjectReference{
+		APIVersion: kautoscaling.SchemeGroupVersion.String(),
+		Kind:       "HorizontalPodAutoscaler",
+		Namespace:  hpa.Namespace,
+		Name:       hpa.Name,
+		UID:        hpa.UID,
+	}
+}
+
+// newTargetStatus returns a TargetStatus which has LastScaleTime and ScaleDownTime of the previous status.
+func newTargetStatus(namespace, name string, previous *scheduledpodscaler.Status) scheduledpodscaler.TargetStatus {
+	ts := scheduledpodscaler.TargetStatus{Namespace: namespace, Name: name}
+	if p := previous.FindTarget(namespace, name); p != nil {
+		ts.LastScaleTime = p.LastScaleTime
+		ts.ScaleDownTime = p.ScaleDownTime
+	}
+	return ts
+}
+
+// deleteTargetMetrics removes the replicas metrics of the previous targets which are not observed in the targets,
+// i.e. the targets which are no longer found or skipped.
+// It must be called before the targets of the status are replaced.
+func (r *Reconcile) deleteTargetMetrics(scheduledPodScaler *scheduledpodscaler.ScheduledPodScaler, targets []scheduledpodscaler.TargetStatus) {
+	observed := make(map[types.NamespacedName]bool)
+	for _, ts := range targets {
+		if ts.Skipped == "" {
+			observed[types.NamespacedName{Namespace: ts.Namespace, Name: ts.Name}] = true
+		}
+	}
+	for _, ts := range scheduledPodScaler.Status.Targets {
+		target := types.NamespacedName{Namespace: ts.Namespace, Name: ts.Name}
+		if !observed[target] {
+			r.Metrics.DeleteTarget(scheduledPodScalerName(scheduledPodScaler), target)
+		}
+	}
+}
+
+// setTargets replaces the targets of the status.
+// It updates LastScaleTime if any target has been scaled now.
+func setTargets(status *scheduledpodscaler.Status, targets []scheduledpodscaler.TargetStatus, now time.Time) {
+	status.Targets = targets
+	for _, ts := range targets {
+		if ts.LastScaleTime.Equal(now) {
+			status.LastScaleTime = now
+		}
+	}
+}
+
+// describeTarget returns a human readable description of the target, e.g. name=foo or selector=app=foo.
+func describeTarget(target scheduledpodscaler.ScaleTarget) string {
+	if target.Name != "" {
+		return fmt.Sprintf("name=%s", target.Name)
+	}
+	return fmt.Sprintf("selector=%s", target.Selector)
+}
+
+func targetsFoundCondition(target scheduledpodscaler.ScaleTarget, count int, now time.Time) scheduledpodscaler.Condition {
+	if count == 0 {
+		return targetsNotFoundCondition("TargetNotFound",
+			fmt.Sprintf("no %s found by %s", target.GroupVersionKind.Kind, describeTarget(target)), now)
+	}
+	return scheduledpodscaler.Condition{
+		Type:               scheduledpodscaler.ConditionTargetsFound,
+		Status:             kcore.ConditionTrue,
+		LastTransitionTime: now,
+		Reason:             "TargetFound",
+		Message:            fmt.Sprintf("found %d %s by %s", count, target.GroupVersionKind.Kind, describeTarget(target)),
+	}
+}
+
+func targetsNotFoundCondition(reason, message string, now time.Time) scheduledpodscaler.Condition {
+	return scheduledpodscaler.Condition{
+		Type:               scheduledpodscaler.ConditionTargetsFound,
+		Status:             kcore.ConditionFalse,
+		LastTransitionTime: now,
+		Reason:             reason,
+		Message:            message,
+	}
+}
+
+// scaledCondition returns the Scaled condition of the targets.
+// The skipped targets are not counted.
+// If dryRun is true, it is False while any target does not have the desired replicas.
+// It is False while any target is waiting for the scale-down delay or ramping to the desired replicas.
+func scaledCondition(target scheduledpodscaler.ScaleTarget, targets []scheduledpodscaler.TargetStatus, dryRun bool, now time.Time) scheduledpodscaler.Condition {
+	if len(targets) == 0 {
+		return notScaledCondition("TargetNotFound", "no target to scale", now)
+	}
+	var skipped, scaleNeeded, ramping, delayed int
+	for _, ts := range targets {
+		if ts.Skipped != "" {
+			skipped++
+			continue
+		}
+		if ts.IsScaleNeeded() {
+			scaleNeeded++
+		}
+		if !ts.NextStepTime.IsZero() {
+			ramping++
+		}
+		if ts.ScaleDownTime.After(now) {
+			delayed++
+		}
+	}
+	count := len(targets) - skipped
+	if dryRun && scaleNeeded > 0 {
+		return notScaledCondition("DryRun",
+			fmt.Sprintf("%d of %d %s would be scaled but dry-run is enabled", scaleNeeded, count, target.GroupVersionKind.Kind), now)
+	}
+	if delayed > 0 {
+		return notScaledCondition("ScaleDownDelayed",
+			fmt.Sprintf("%d of %d %s will be scaled down after the delay", delayed, count, target.GroupVersionKind.Kind), now)
+	}
+	if ramping > 0 {
+		return notScaledCondition("Ramping",
+			fmt.Sprintf("%d of %d %s are ramping to the desired replicas", ramping, count, target.GroupVersionKind.Kind), now)
+	}
+	message := fmt.Sprintf("%d %s have the desired replicas", count, target.GroupVersionKind.Kind)
+	if skipped > 0 {
+		message += fmt.Sprintf(" and %d skipped", skipped)
+	}
+	return scheduledpodscaler.Condition{
+		Type:               scheduledpodscaler.ConditionScaled,
+		Status:             kcore.ConditionTrue,
+		LastTransitionTime: now,
+		Reason:             "Scaled",
+		Message:            message,
+	}
+}
+
+func notScaledCondition(reason, message string, now time.Time) scheduledpodscaler.Condition {
+	return scheduledpodscaler.Condition{
+		Type:               scheduledpodscaler.ConditionScaled,
+		Status:             kcore.ConditionFalse,
+		LastTransitionTime: now,
+		Reason:             reason,
+		Message:            message,
+	}
 }
@@ -3,40 +3,2546 @@ package reconcile
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	testingLogr "github.com/go-logr/logr/testing"
 	"github.com/golang/mock/gomock"
 	"github.com/google/go-cmp/cmp"
+	"github.com/int128/scheduled-scaler/pkg/domain/errors"
 	"github.com/int128/scheduled-scaler/pkg/domain/schedule"
 	"github.com/int128/scheduled-scaler/pkg/domain/scheduledpodscaler"
-	"github.com/int128/scheduled-scaler/pkg/repositories/deployment/mock_deployment"
+	"github.com/int128/scheduled-scaler/pkg/domain/workload"
+	"github.com/int128/scheduled-scaler/pkg/infrastructure/metrics"
+	"github.com/int128/scheduled-scaler/pkg/repositories/horizontalpodautoscaler/mock_horizontalpodautoscaler"
+	"github.com/int128/scheduled-scaler/pkg/repositories/namespace/mock_namespace"
 	"github.com/int128/scheduled-scaler/pkg/repositories/scheduledpodscaler/mock_scheduledpodscaler"
-	kapps "k8s.io/api/apps/v1"
+	"github.com/int128/scheduled-scaler/pkg/repositories/workload/mock_workload"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	kautoscaling "k8s.io/api/autoscaling/v1"
+	kcore "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/pointer"
 )
 
+var deploymentGVK = schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+
 type testingClock time.Time
 
-func (t testingClock) Now() time.Time {
-	return time.Time(t)
-}
+func (t testingClock) Now() time.Time {
+	return time.Time(t)
+}
+
+func TestReconcile_Do(t *testing.T) {
+	ctx := context.TODO()
+
+	t.Run("ScaleDeployment", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		scheduledPodScaler1 := scheduledpodscaler.ScheduledPodScaler{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "example1"},
+			Spec: scheduledpodscaler.Spec{
+				ScaleTarget: scheduledpodscaler.ScaleTarget{
+					GroupVersionKind: deploymentGVK,
+					Selector:         labels.SelectorFromSet(labels.Set{"app": "server1"}),
+				},
+				ScaleRules: []scheduledpodscaler.ScaleRule{
+					{
+						Range: &schedule.DailyRange{
+							StartTime: 12 * time.Hour,
+							EndTime:   19 * time.Hour,
+						},
+						Timezone: time.UTC,
+						ScaleSpec: scheduledpodscaler.ScaleSpec{
+							Replicas: 5,
+						},
+					},
+				},
+			},
+		}
+		mockScheduledPodScalerRepository := mock_scheduledpodscaler.NewMockInterface(ctrl)
+		mockScheduledPodScalerRepository.EXPECT().
+			GetByName(gomock.Not(nil), types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			}).
+			Return(&scheduledPodScaler1, nil)
+		mockScheduledPodScalerRepository.EXPECT().
+			UpdateStatus(gomock.Not(nil), &scheduledpodscaler.ScheduledPodScaler{
+				ObjectMeta: scheduledPodScaler1.ObjectMeta,
+				Spec:       scheduledPodScaler1.Spec,
+				Status: scheduledpodscaler.Status{
+					ActiveRule:        "schedule[0]",
+					DesiredScaleSpec:  &scheduledpodscaler.ScaleSpec{Replicas: 5},
+					LastScaleTime:     time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+					NextReconcileTime: time.Date(2019, 12, 1, 19, 0, 0, 0, time.UTC),
+					Targets: []scheduledpodscaler.TargetStatus{
+						{
+							Namespace:     "fixture",
+							Name:          "server1",
+							Current:       scheduledpodscaler.ScaleSpec{Replicas: 3},
+							Desired:       scheduledpodscaler.ScaleSpec{Replicas: 5},
+							LastScaleTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+						},
+					},
+					Conditions: []scheduledpodscaler.Condition{
+						{
+							Type:               scheduledpodscaler.ConditionScheduleValid,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Valid",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionSuspended,
+							Status:             kcore.ConditionFalse,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "NotSuspended",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionTargetsFound,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "TargetFound",
+							Message:            "found 1 Deployment by selector=app=server1",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionScaled,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Scaled",
+							Message:            "1 Deployment have the desired replicas",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionReady,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Ready",
+						},
+					},
+				},
+			})
+
+		workload1 := workload.Workload{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "server1"},
+			Replicas:   3,
+		}
+		mockWorkloadRepository := mock_workload.NewMockInterface(ctrl)
+		mockWorkloadRepository.EXPECT().
+			FindBySelectors(gomock.Not(nil), "fixture", deploymentGVK, labels.SelectorFromSet(labels.Set{"app": "server1"})).
+			Return([]workload.Workload{workload1}, nil)
+		mockWorkloadRepository.EXPECT().
+			Scale(gomock.Not(nil), &workload1, int32(5))
+
+		recorder := record.NewFakeRecorder(10)
+		m := metrics.New()
+		tc := testingClock(time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC))
+		r := Reconcile{
+			Log:                          testingLogr.TestLogger{T: t},
+			Clock:                        tc,
+			Recorder:                     recorder,
+			Metrics:                      m,
+			ScheduledPodScalerRepository: mockScheduledPodScalerRepository,
+			WorkloadRepository:           mockWorkloadRepository,
+		}
+		input := Input{
+			Target: types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			},
+		}
+		got, err := r.Do(ctx, input)
+		if err != nil {
+			t.Fatalf("Do error: %+v", err)
+		}
+		want := &Output{
+			NextReconcileAfter: 4 * time.Hour,
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want, +got):\n%s", diff)
+		}
+		if diff := cmp.Diff([]string{
+			"Normal Scaled Scaled fixture/server1 from 3 to 5 by rule schedule[0]",
+			"Normal Scaled Scaled fixture/server1 from 3 to 5 by rule schedule[0]",
+		}, receiveEvents(recorder)); diff != "" {
+			t.Errorf("events mismatch (-want, +got):\n%s", diff)
+		}
+		if got := testutil.ToFloat64(m.ScaleOperations.WithLabelValues("fixture", "example1", "success")); got != 1 {
+			t.Errorf("scale operations wants 1 but %v", got)
+		}
+		if got := testutil.ToFloat64(m.DesiredReplicas.WithLabelValues("fixture", "example1", "fixture", "server1")); got != 5 {
+			t.Errorf("desired replicas wants 5 but %v", got)
+		}
+		if got := testutil.ToFloat64(m.NextReconcileSeconds.WithLabelValues("fixture", "example1")); got != 4*60*60 {
+			t.Errorf("next reconcile seconds wants %v but %v", 4*60*60, got)
+		}
+	})
+
+	t.Run("ScaleDeploymentByNamedRule", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		scheduledPodScaler1 := scheduledpodscaler.ScheduledPodScaler{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "example1"},
+			Spec: scheduledpodscaler.Spec{
+				ScaleTarget: scheduledpodscaler.ScaleTarget{
+					GroupVersionKind: deploymentGVK,
+					Selector:         labels.SelectorFromSet(labels.Set{"app": "server1"}),
+				},
+				ScaleRules: []scheduledpodscaler.ScaleRule{
+					{
+						Name: "daytime",
+						Range: &schedule.DailyRange{
+							StartTime: 12 * time.Hour,
+							EndTime:   19 * time.Hour,
+						},
+						Timezone: time.UTC,
+						ScaleSpec: scheduledpodscaler.ScaleSpec{
+							Replicas: 5,
+						},
+					},
+				},
+			},
+		}
+		mockScheduledPodScalerRepository := mock_scheduledpodscaler.NewMockInterface(ctrl)
+		mockScheduledPodScalerRepository.EXPECT().
+			GetByName(gomock.Not(nil), types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			}).
+			Return(&scheduledPodScaler1, nil)
+		mockScheduledPodScalerRepository.EXPECT().
+			UpdateStatus(gomock.Not(nil), &scheduledpodscaler.ScheduledPodScaler{
+				ObjectMeta: scheduledPodScaler1.ObjectMeta,
+				Spec:       scheduledPodScaler1.Spec,
+				Status: scheduledpodscaler.Status{
+					ActiveRule:        "daytime",
+					DesiredScaleSpec:  &scheduledpodscaler.ScaleSpec{Replicas: 5},
+					LastScaleTime:     time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+					NextReconcileTime: time.Date(2019, 12, 1, 19, 0, 0, 0, time.UTC),
+					Targets: []scheduledpodscaler.TargetStatus{
+						{
+							Namespace:     "fixture",
+							Name:          "server1",
+							Current:       scheduledpodscaler.ScaleSpec{Replicas: 3},
+							Desired:       scheduledpodscaler.ScaleSpec{Replicas: 5},
+							LastScaleTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+						},
+					},
+					Conditions: []scheduledpodscaler.Condition{
+						{
+							Type:               scheduledpodscaler.ConditionScheduleValid,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Valid",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionSuspended,
+							Status:             kcore.ConditionFalse,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "NotSuspended",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionTargetsFound,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "TargetFound",
+							Message:            "found 1 Deployment by selector=app=server1",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionScaled,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Scaled",
+							Message:            "1 Deployment have the desired replicas",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionReady,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Ready",
+						},
+					},
+				},
+			})
+
+		workload1 := workload.Workload{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "server1"},
+			Replicas:   3,
+		}
+		mockWorkloadRepository := mock_workload.NewMockInterface(ctrl)
+		mockWorkloadRepository.EXPECT().
+			FindBySelectors(gomock.Not(nil), "fixture", deploymentGVK, labels.SelectorFromSet(labels.Set{"app": "server1"})).
+			Return([]workload.Workload{workload1}, nil)
+		mockWorkloadRepository.EXPECT().
+			Scale(gomock.Not(nil), &workload1, int32(5))
+
+		recorder := record.NewFakeRecorder(10)
+		tc := testingClock(time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC))
+		r := Reconcile{
+			Log:                          testingLogr.TestLogger{T: t},
+			Clock:                        tc,
+			Recorder:                     recorder,
+			Metrics:                      metrics.New(),
+			ScheduledPodScalerRepository: mockScheduledPodScalerRepository,
+			WorkloadRepository:           mockWorkloadRepository,
+		}
+		input := Input{
+			Target: types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			},
+		}
+		got, err := r.Do(ctx, input)
+		if err != nil {
+			t.Fatalf("Do error: %+v", err)
+		}
+		want := &Output{
+			NextReconcileAfter: 4 * time.Hour,
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want, +got):\n%s", diff)
+		}
+		if diff := cmp.Diff([]string{
+			"Normal Scaled Scaled fixture/server1 from 3 to 5 by rule daytime",
+			"Normal Scaled Scaled fixture/server1 from 3 to 5 by rule daytime",
+		}, receiveEvents(recorder)); diff != "" {
+			t.Errorf("events mismatch (-want, +got):\n%s", diff)
+		}
+	})
+
+	t.Run("RampUpDeployment", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		scheduledPodScaler1 := scheduledpodscaler.ScheduledPodScaler{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "example1"},
+			Spec: scheduledpodscaler.Spec{
+				ScaleTarget: scheduledpodscaler.ScaleTarget{
+					GroupVersionKind: deploymentGVK,
+					Selector:         labels.SelectorFromSet(labels.Set{"app": "server1"}),
+				},
+				ScaleRules: []scheduledpodscaler.ScaleRule{
+					{
+						Range: &schedule.DailyRange{
+							StartTime: 12 * time.Hour,
+							EndTime:   19 * time.Hour,
+						},
+						Timezone: time.UTC,
+						ScaleSpec: scheduledpodscaler.ScaleSpec{
+							Replicas: 40,
+						},
+						RampUp: &scheduledpodscaler.RampPolicy{MaxStep: 10, Interval: 5 * time.Minute},
+					},
+				},
+			},
+		}
+		mockScheduledPodScalerRepository := mock_scheduledpodscaler.NewMockInterface(ctrl)
+		mockScheduledPodScalerRepository.EXPECT().
+			GetByName(gomock.Not(nil), types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			}).
+			Return(&scheduledPodScaler1, nil)
+		mockScheduledPodScalerRepository.EXPECT().
+			UpdateStatus(gomock.Not(nil), &scheduledpodscaler.ScheduledPodScaler{
+				ObjectMeta: scheduledPodScaler1.ObjectMeta,
+				Spec:       scheduledPodScaler1.Spec,
+				Status: scheduledpodscaler.Status{
+					ActiveRule:        "schedule[0]",
+					DesiredScaleSpec:  &scheduledpodscaler.ScaleSpec{Replicas: 40},
+					LastScaleTime:     time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+					NextReconcileTime: time.Date(2019, 12, 1, 15, 5, 0, 0, time.UTC),
+					Targets: []scheduledpodscaler.TargetStatus{
+						{
+							Namespace:     "fixture",
+							Name:          "server1",
+							Current:       scheduledpodscaler.ScaleSpec{Replicas: 2},
+							Desired:       scheduledpodscaler.ScaleSpec{Replicas: 40},
+							LastScaleTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							NextStepTime:  time.Date(2019, 12, 1, 15, 5, 0, 0, time.UTC),
+						},
+					},
+					Conditions: []scheduledpodscaler.Condition{
+						{
+							Type:               scheduledpodscaler.ConditionScheduleValid,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Valid",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionSuspended,
+							Status:             kcore.ConditionFalse,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "NotSuspended",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionTargetsFound,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "TargetFound",
+							Message:            "found 1 Deployment by selector=app=server1",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionScaled,
+							Status:             kcore.ConditionFalse,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Ramping",
+							Message:            "1 of 1 Deployment are ramping to the desired replicas",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionReady,
+							Status:             kcore.ConditionFalse,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Ramping",
+							Message:            "1 of 1 Deployment are ramping to the desired replicas",
+						},
+					},
+				},
+			})
+
+		workload1 := workload.Workload{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "server1"},
+			Replicas:   2,
+		}
+		mockWorkloadRepository := mock_workload.NewMockInterface(ctrl)
+		mockWorkloadRepository.EXPECT().
+			FindBySelectors(gomock.Not(nil), "fixture", deploymentGVK, labels.SelectorFromSet(labels.Set{"app": "server1"})).
+			Return([]workload.Workload{workload1}, nil)
+		mockWorkloadRepository.EXPECT().
+			Scale(gomock.Not(nil), &workload1, int32(12))
+
+		recorder := record.NewFakeRecorder(10)
+		tc := testingClock(time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC))
+		r := Reconcile{
+			Log:                          testingLogr.TestLogger{T: t},
+			Clock:                        tc,
+			Recorder:                     recorder,
+			Metrics:                      metrics.New(),
+			ScheduledPodScalerRepository: mockScheduledPodScalerRepository,
+			WorkloadRepository:           mockWorkloadRepository,
+		}
+		input := Input{
+			Target: types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			},
+		}
+		got, err := r.Do(ctx, input)
+		if err != nil {
+			t.Fatalf("Do error: %+v", err)
+		}
+		want := &Output{
+			NextReconcileAfter: 5 * time.Minute,
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want, +got):\n%s", diff)
+		}
+		if diff := cmp.Diff([]string{
+			"Normal Scaled Scaled fixture/server1 from 2 to 12 toward 40 by rule schedule[0]",
+			"Normal Scaled Scaled fixture/server1 from 2 to 12 toward 40 by rule schedule[0]",
+		}, receiveEvents(recorder)); diff != "" {
+			t.Errorf("events mismatch (-want, +got):\n%s", diff)
+		}
+	})
+
+	t.Run("DelayScaleDownDeployment", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		scheduledPodScaler1 := scheduledpodscaler.ScheduledPodScaler{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "example1"},
+			Spec: scheduledpodscaler.Spec{
+				ScaleTarget: scheduledpodscaler.ScaleTarget{
+					GroupVersionKind: deploymentGVK,
+					Selector:         labels.SelectorFromSet(labels.Set{"app": "server1"}),
+				},
+				DefaultScaleSpec: scheduledpodscaler.ScaleSpec{
+					Replicas: 1,
+				},
+				ScaleDownDelay: 10 * time.Minute,
+			},
+		}
+		mockScheduledPodScalerRepository := mock_scheduledpodscaler.NewMockInterface(ctrl)
+		mockScheduledPodScalerRepository.EXPECT().
+			GetByName(gomock.Not(nil), types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			}).
+			Return(&scheduledPodScaler1, nil)
+		mockScheduledPodScalerRepository.EXPECT().
+			UpdateStatus(gomock.Not(nil), &scheduledpodscaler.ScheduledPodScaler{
+				ObjectMeta: scheduledPodScaler1.ObjectMeta,
+				Spec:       scheduledPodScaler1.Spec,
+				Status: scheduledpodscaler.Status{
+					ActiveRule:        "default",
+					DesiredScaleSpec:  &scheduledpodscaler.ScaleSpec{Replicas: 1},
+					NextReconcileTime: time.Date(2019, 12, 1, 15, 10, 0, 0, time.UTC),
+					Targets: []scheduledpodscaler.TargetStatus{
+						{
+							Namespace:     "fixture",
+							Name:          "server1",
+							Current:       scheduledpodscaler.ScaleSpec{Replicas: 5},
+							Desired:       scheduledpodscaler.ScaleSpec{Replicas: 1},
+							ScaleDownTime: time.Date(2019, 12, 1, 15, 10, 0, 0, time.UTC),
+						},
+					},
+					Conditions: []scheduledpodscaler.Condition{
+						{
+							Type:               scheduledpodscaler.ConditionScheduleValid,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Valid",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionSuspended,
+							Status:             kcore.ConditionFalse,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "NotSuspended",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionTargetsFound,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "TargetFound",
+							Message:            "found 1 Deployment by selector=app=server1",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionScaled,
+							Status:             kcore.ConditionFalse,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "ScaleDownDelayed",
+							Message:            "1 of 1 Deployment will be scaled down after the delay",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionReady,
+							Status:             kcore.ConditionFalse,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "ScaleDownDelayed",
+							Message:            "1 of 1 Deployment will be scaled down after the delay",
+						},
+					},
+				},
+			})
+
+		workload1 := workload.Workload{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "server1"},
+			Replicas:   5,
+		}
+		mockWorkloadRepository := mock_workload.NewMockInterface(ctrl)
+		mockWorkloadRepository.EXPECT().
+			FindBySelectors(gomock.Not(nil), "fixture", deploymentGVK, labels.SelectorFromSet(labels.Set{"app": "server1"})).
+			Return([]workload.Workload{workload1}, nil)
+
+		recorder := record.NewFakeRecorder(10)
+		tc := testingClock(time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC))
+		r := Reconcile{
+			Log:                          testingLogr.TestLogger{T: t},
+			Clock:                        tc,
+			Recorder:                     recorder,
+			Metrics:                      metrics.New(),
+			ScheduledPodScalerRepository: mockScheduledPodScalerRepository,
+			WorkloadRepository:           mockWorkloadRepository,
+		}
+		input := Input{
+			Target: types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			},
+		}
+		got, err := r.Do(ctx, input)
+		if err != nil {
+			t.Fatalf("Do error: %+v", err)
+		}
+		want := &Output{
+			NextReconcileAfter: 10 * time.Minute,
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want, +got):\n%s", diff)
+		}
+		if events := receiveEvents(recorder); len(events) > 0 {
+			t.Errorf("events wants empty but %v", events)
+		}
+	})
+
+	t.Run("DelayScaleDownDeploymentTwice", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		scheduledPodScaler1 := scheduledpodscaler.ScheduledPodScaler{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "example1"},
+			Spec: scheduledpodscaler.Spec{
+				ScaleTarget: scheduledpodscaler.ScaleTarget{
+					GroupVersionKind: deploymentGVK,
+					Selector:         labels.SelectorFromSet(labels.Set{"app": "server1"}),
+				},
+				ScaleRules: []scheduledpodscaler.ScaleRule{
+					{
+						Range: &schedule.DailyRange{
+							StartTime: 18 * time.Hour,
+							EndTime:   22 * time.Hour,
+						},
+						Timezone: time.UTC,
+						ScaleSpec: scheduledpodscaler.ScaleSpec{
+							Replicas: 5,
+						},
+					},
+				},
+				DefaultScaleSpec: scheduledpodscaler.ScaleSpec{
+					Replicas: 2,
+				},
+				ScaleDownDelay: 10 * time.Minute,
+			},
+			Status: scheduledpodscaler.Status{
+				Targets: []scheduledpodscaler.TargetStatus{
+					{
+						Namespace:     "fixture",
+						Name:          "server1",
+						ScaleDownTime: time.Date(2019, 12, 1, 18, 10, 0, 0, time.UTC),
+					},
+				},
+			},
+		}
+		var statuses []scheduledpodscaler.Status
+		mockScheduledPodScalerRepository := mock_scheduledpodscaler.NewMockInterface(ctrl)
+		mockScheduledPodScalerRepository.EXPECT().
+			GetByName(gomock.Not(nil), types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			}).
+			Return(&scheduledPodScaler1, nil).
+			Times(2)
+		mockScheduledPodScalerRepository.EXPECT().
+			UpdateStatus(gomock.Not(nil), gomock.Any()).
+			DoAndReturn(func(_ context.Context, s *scheduledpodscaler.ScheduledPodScaler) error {
+				statuses = append(statuses, s.Status)
+				return nil
+			}).
+			Times(2)
+
+		workload1 := workload.Workload{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "server1"},
+			Replicas:   10,
+		}
+		mockWorkloadRepository := mock_workload.NewMockInterface(ctrl)
+		mockWorkloadRepository.EXPECT().
+			FindBySelectors(gomock.Not(nil), "fixture", deploymentGVK, labels.SelectorFromSet(labels.Set{"app": "server1"})).
+			DoAndReturn(func(context.Context, string, schema.GroupVersionKind, labels.Selector) ([]workload.Workload, error) {
+				return []workload.Workload{workload1}, nil
+			}).
+			Times(2)
+		mockWorkloadRepository.EXPECT().
+			Scale(gomock.Not(nil), &workload1, int32(5)).
+			DoAndReturn(func(_ context.Context, w *workload.Workload, replicas int32) error {
+				workload1.Replicas = replicas
+				return nil
+			})
+
+		recorder := record.NewFakeRecorder(10)
+		r := Reconcile{
+			Log:                          testingLogr.TestLogger{T: t},
+			Recorder:                     recorder,
+			Metrics:                      metrics.New(),
+			ScheduledPodScalerRepository: mockScheduledPodScalerRepository,
+			WorkloadRepository:           mockWorkloadRepository,
+		}
+		input := Input{
+			Target: types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			},
+		}
+
+		// the first scale-down is applied after the delay
+		r.Clock = testingClock(time.Date(2019, 12, 1, 18, 10, 0, 0, time.UTC))
+		if _, err := r.Do(ctx, input); err != nil {
+			t.Fatalf("Do error: %+v", err)
+		}
+		if len(statuses) != 1 {
+			t.Fatalf("len(statuses) wants 1 but was %d", len(statuses))
+		}
+		if got := statuses[0].Targets[0].ScaleDownTime; !got.IsZero() {
+			t.Errorf("ScaleDownTime wants zero but was %s", got)
+		}
+		scheduledPodScaler1.Status = statuses[0]
+
+		// the second scale-down waits for the delay again
+		r.Clock = testingClock(time.Date(2019, 12, 1, 22, 1, 0, 0, time.UTC))
+		got, err := r.Do(ctx, input)
+		if err != nil {
+			t.Fatalf("Do error: %+v", err)
+		}
+		want := &Output{
+			NextReconcileAfter: 10 * time.Minute,
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want, +got):\n%s", diff)
+		}
+		if len(statuses) != 2 {
+			t.Fatalf("len(statuses) wants 2 but was %d", len(statuses))
+		}
+		wantTargets := []scheduledpodscaler.TargetStatus{
+			{
+				Namespace:     "fixture",
+				Name:          "server1",
+				Current:       scheduledpodscaler.ScaleSpec{Replicas: 5},
+				Desired:       scheduledpodscaler.ScaleSpec{Replicas: 2},
+				LastScaleTime: time.Date(2019, 12, 1, 18, 10, 0, 0, time.UTC),
+				ScaleDownTime: time.Date(2019, 12, 1, 22, 11, 0, 0, time.UTC),
+			},
+		}
+		if diff := cmp.Diff(wantTargets, statuses[1].Targets); diff != "" {
+			t.Errorf("targets mismatch (-want, +got):\n%s", diff)
+		}
+	})
+
+	t.Run("DryRun", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		scheduledPodScaler1 := scheduledpodscaler.ScheduledPodScaler{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "example1"},
+			Spec: scheduledpodscaler.Spec{
+				ScaleTarget: scheduledpodscaler.ScaleTarget{
+					GroupVersionKind: deploymentGVK,
+					Selector:         labels.SelectorFromSet(labels.Set{"app": "server1"}),
+				},
+				ScaleRules: []scheduledpodscaler.ScaleRule{
+					{
+						Range: &schedule.DailyRange{
+							StartTime: 12 * time.Hour,
+							EndTime:   19 * time.Hour,
+						},
+						Timezone: time.UTC,
+						ScaleSpec: scheduledpodscaler.ScaleSpec{
+							Replicas: 5,
+						},
+					},
+				},
+				DryRun: true,
+			},
+		}
+		mockScheduledPodScalerRepository := mock_scheduledpodscaler.NewMockInterface(ctrl)
+		mockScheduledPodScalerRepository.EXPECT().
+			GetByName(gomock.Not(nil), types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			}).
+			Return(&scheduledPodScaler1, nil)
+		mockScheduledPodScalerRepository.EXPECT().
+			UpdateStatus(gomock.Not(nil), &scheduledpodscaler.ScheduledPodScaler{
+				ObjectMeta: scheduledPodScaler1.ObjectMeta,
+				Spec:       scheduledPodScaler1.Spec,
+				Status: scheduledpodscaler.Status{
+					ActiveRule:        "schedule[0]",
+					DesiredScaleSpec:  &scheduledpodscaler.ScaleSpec{Replicas: 5},
+					NextReconcileTime: time.Date(2019, 12, 1, 19, 0, 0, 0, time.UTC),
+					Targets: []scheduledpodscaler.TargetStatus{
+						{
+							Namespace: "fixture",
+							Name:      "server1",
+							Current:   scheduledpodscaler.ScaleSpec{Replicas: 3},
+							Desired:   scheduledpodscaler.ScaleSpec{Replicas: 5},
+						},
+					},
+					Conditions: []scheduledpodscaler.Condition{
+						{
+							Type:               scheduledpodscaler.ConditionScheduleValid,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Valid",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionSuspended,
+							Status:             kcore.ConditionFalse,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "NotSuspended",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionTargetsFound,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "TargetFound",
+							Message:            "found 1 Deployment by selector=app=server1",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionScaled,
+							Status:             kcore.ConditionFalse,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "DryRun",
+							Message:            "1 of 1 Deployment would be scaled but dry-run is enabled",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionReady,
+							Status:             kcore.ConditionFalse,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "DryRun",
+							Message:            "1 of 1 Deployment would be scaled but dry-run is enabled",
+						},
+					},
+				},
+			})
+
+		workload1 := workload.Workload{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "server1"},
+			Replicas:   3,
+		}
+		mockWorkloadRepository := mock_workload.NewMockInterface(ctrl)
+		mockWorkloadRepository.EXPECT().
+			FindBySelectors(gomock.Not(nil), "fixture", deploymentGVK, labels.SelectorFromSet(labels.Set{"app": "server1"})).
+			Return([]workload.Workload{workload1}, nil)
+
+		recorder := record.NewFakeRecorder(10)
+		tc := testingClock(time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC))
+		r := Reconcile{
+			Log:                          testingLogr.TestLogger{T: t},
+			Clock:                        tc,
+			Recorder:                     recorder,
+			Metrics:                      metrics.New(),
+			ScheduledPodScalerRepository: mockScheduledPodScalerRepository,
+			WorkloadRepository:           mockWorkloadRepository,
+		}
+		input := Input{
+			Target: types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			},
+		}
+		got, err := r.Do(ctx, input)
+		if err != nil {
+			t.Fatalf("Do error: %+v", err)
+		}
+		want := &Output{
+			NextReconcileAfter: 4 * time.Hour,
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want, +got):\n%s", diff)
+		}
+		if diff := cmp.Diff([]string{
+			"Normal DryRun Would scale fixture/server1 from 3 to 5 by rule schedule[0]",
+			"Normal DryRun Would scale fixture/server1 from 3 to 5 by rule schedule[0]",
+		}, receiveEvents(recorder)); diff != "" {
+			t.Errorf("events mismatch (-want, +got):\n%s", diff)
+		}
+	})
+
+	t.Run("Suspended", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		scheduledPodScaler1 := scheduledpodscaler.ScheduledPodScaler{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "example1"},
+			Spec: scheduledpodscaler.Spec{
+				ScaleTarget: scheduledpodscaler.ScaleTarget{
+					GroupVersionKind: deploymentGVK,
+					Selector:         labels.SelectorFromSet(labels.Set{"app": "server1"}),
+				},
+				DefaultScaleSpec: scheduledpodscaler.ScaleSpec{
+					Replicas: 1,
+				},
+				Suspend:      true,
+				SuspendUntil: time.Date(2019, 12, 1, 18, 0, 0, 0, time.UTC),
+			},
+			Status: scheduledpodscaler.Status{
+				ActiveRule:       "default",
+				DesiredScaleSpec: &scheduledpodscaler.ScaleSpec{Replicas: 1},
+			},
+		}
+		mockScheduledPodScalerRepository := mock_scheduledpodscaler.NewMockInterface(ctrl)
+		mockScheduledPodScalerRepository.EXPECT().
+			GetByName(gomock.Not(nil), types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			}).
+			Return(&scheduledPodScaler1, nil)
+		mockScheduledPodScalerRepository.EXPECT().
+			UpdateStatus(gomock.Not(nil), &scheduledpodscaler.ScheduledPodScaler{
+				ObjectMeta: scheduledPodScaler1.ObjectMeta,
+				Spec:       scheduledPodScaler1.Spec,
+				Status: scheduledpodscaler.Status{
+					ActiveRule:        "default",
+					DesiredScaleSpec:  &scheduledpodscaler.ScaleSpec{Replicas: 1},
+					NextReconcileTime: time.Date(2019, 12, 1, 18, 0, 0, 0, time.UTC),
+					Conditions: []scheduledpodscaler.Condition{
+						{
+							Type:               scheduledpodscaler.ConditionScheduleValid,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Valid",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionSuspended,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Suspended",
+							Message:            "suspended until 2019-12-01T18:00:00Z",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionReady,
+							Status:             kcore.ConditionFalse,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Suspended",
+							Message:            "suspended until 2019-12-01T18:00:00Z",
+						},
+					},
+				},
+			})
+
+		recorder := record.NewFakeRecorder(10)
+		tc := testingClock(time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC))
+		r := Reconcile{
+			Log:                          testingLogr.TestLogger{T: t},
+			Clock:                        tc,
+			Recorder:                     recorder,
+			Metrics:                      metrics.New(),
+			ScheduledPodScalerRepository: mockScheduledPodScalerRepository,
+			WorkloadRepository:           mock_workload.NewMockInterface(ctrl),
+		}
+		input := Input{
+			Target: types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			},
+		}
+		got, err := r.Do(ctx, input)
+		if err != nil {
+			t.Fatalf("Do error: %+v", err)
+		}
+		want := &Output{
+			NextReconcileAfter: 3 * time.Hour,
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want, +got):\n%s", diff)
+		}
+	})
+
+	t.Run("ScaleDeploymentByPercentage", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		scheduledPodScaler1 := scheduledpodscaler.ScheduledPodScaler{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "example1"},
+			Spec: scheduledpodscaler.Spec{
+				ScaleTarget: scheduledpodscaler.ScaleTarget{
+					GroupVersionKind: deploymentGVK,
+					Selector:         labels.SelectorFromSet(labels.Set{"app": "server1"}),
+				},
+				ScaleRules: []scheduledpodscaler.ScaleRule{
+					{
+						Range: &schedule.DailyRange{
+							StartTime: 12 * time.Hour,
+							EndTime:   19 * time.Hour,
+						},
+						Timezone: time.UTC,
+						ScaleSpec: scheduledpodscaler.ScaleSpec{
+							Percentage: &scheduledpodscaler.Percentage{Value: 25, Rounding: scheduledpodscaler.RoundingUp},
+						},
+					},
+				},
+			},
+		}
+		mockScheduledPodScalerRepository := mock_scheduledpodscaler.NewMockInterface(ctrl)
+		mockScheduledPodScalerRepository.EXPECT().
+			GetByName(gomock.Not(nil), types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			}).
+			Return(&scheduledPodScaler1, nil)
+		mockScheduledPodScalerRepository.EXPECT().
+			UpdateStatus(gomock.Not(nil), &scheduledpodscaler.ScheduledPodScaler{
+				ObjectMeta: scheduledPodScaler1.ObjectMeta,
+				Spec:       scheduledPodScaler1.Spec,
+				Status: scheduledpodscaler.Status{
+					ActiveRule:        "schedule[0]",
+					DesiredScaleSpec:  &scheduledPodScaler1.Spec.ScaleRules[0].ScaleSpec,
+					LastScaleTime:     time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+					NextReconcileTime: time.Date(2019, 12, 1, 19, 0, 0, 0, time.UTC),
+					Targets: []scheduledpodscaler.TargetStatus{
+						{
+							Namespace:     "fixture",
+							Name:          "server1",
+							Current:       scheduledpodscaler.ScaleSpec{Replicas: 40},
+							Desired:       scheduledpodscaler.ScaleSpec{Replicas: 10},
+							LastScaleTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+						},
+						{
+							Namespace:     "fixture",
+							Name:          "server2",
+							Current:       scheduledpodscaler.ScaleSpec{Replicas: 7},
+							Desired:       scheduledpodscaler.ScaleSpec{Replicas: 2},
+							LastScaleTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+						},
+					},
+					Conditions: []scheduledpodscaler.Condition{
+						{
+							Type:               scheduledpodscaler.ConditionScheduleValid,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Valid",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionSuspended,
+							Status:             kcore.ConditionFalse,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "NotSuspended",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionTargetsFound,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "TargetFound",
+							Message:            "found 2 Deployment by selector=app=server1",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionScaled,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Scaled",
+							Message:            "2 Deployment have the desired replicas",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionReady,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Ready",
+						},
+					},
+				},
+			})
+
+		workload1 := workload.Workload{
+			TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "fixture",
+				Name:        "server1",
+				Annotations: map[string]string{scheduledpodscaler.BaselineReplicasAnnotation: "40"},
+			},
+			Replicas: 40,
+		}
+		workload2 := workload.Workload{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "server2"},
+			Replicas:   7,
+		}
+		mockWorkloadRepository := mock_workload.NewMockInterface(ctrl)
+		mockWorkloadRepository.EXPECT().
+			FindBySelectors(gomock.Not(nil), "fixture", deploymentGVK, labels.SelectorFromSet(labels.Set{"app": "server1"})).
+			Return([]workload.Workload{workload1, workload2}, nil)
+		mockWorkloadRepository.EXPECT().
+			Scale(gomock.Not(nil), &workload1, int32(10))
+		mockWorkloadRepository.EXPECT().
+			Annotate(gomock.Not(nil), &workload2, scheduledpodscaler.BaselineReplicasAnnotation, "7")
+		mockWorkloadRepository.EXPECT().
+			Scale(gomock.Not(nil), &workload2, int32(2))
+
+		recorder := record.NewFakeRecorder(10)
+		m := metrics.New()
+		tc := testingClock(time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC))
+		r := Reconcile{
+			Log:                          testingLogr.TestLogger{T: t},
+			Clock:                        tc,
+			Recorder:                     recorder,
+			Metrics:                      m,
+			ScheduledPodScalerRepository: mockScheduledPodScalerRepository,
+			WorkloadRepository:           mockWorkloadRepository,
+		}
+		input := Input{
+			Target: types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			},
+		}
+		got, err := r.Do(ctx, input)
+		if err != nil {
+			t.Fatalf("Do error: %+v", err)
+		}
+		want := &Output{
+			NextReconcileAfter: 4 * time.Hour,
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want, +got):\n%s", diff)
+		}
+		if diff := cmp.Diff([]string{
+			"Normal Scaled Scaled fixture/server1 from 40 to 10 by rule schedule[0]",
+			"Normal Scaled Scaled fixture/server1 from 40 to 10 by rule schedule[0]",
+			"Normal Scaled Scaled fixture/server2 from 7 to 2 by rule schedule[0]",
+			"Normal Scaled Scaled fixture/server2 from 7 to 2 by rule schedule[0]",
+		}, receiveEvents(recorder)); diff != "" {
+			t.Errorf("events mismatch (-want, +got):\n%s", diff)
+		}
+	})
+
+	t.Run("InvalidBaselineAnnotation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		const invalidBaselineMessage = `could not find the baseline replicas: invalid annotation scheduledscaling.int128.github.io/baseline-replicas: strconv.ParseInt: parsing "abc": invalid syntax`
+
+		scheduledPodScaler1 := scheduledpodscaler.ScheduledPodScaler{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "example1"},
+			Spec: scheduledpodscaler.Spec{
+				ScaleTarget: scheduledpodscaler.ScaleTarget{
+					GroupVersionKind: deploymentGVK,
+					Selector:         labels.SelectorFromSet(labels.Set{"app": "server1"}),
+				},
+				ScaleRules: []scheduledpodscaler.ScaleRule{
+					{
+						Range: &schedule.DailyRange{
+							StartTime: 12 * time.Hour,
+							EndTime:   19 * time.Hour,
+						},
+						Timezone: time.UTC,
+						ScaleSpec: scheduledpodscaler.ScaleSpec{
+							Percentage: &scheduledpodscaler.Percentage{Value: 25, Rounding: scheduledpodscaler.RoundingUp},
+						},
+					},
+				},
+			},
+		}
+		mockScheduledPodScalerRepository := mock_scheduledpodscaler.NewMockInterface(ctrl)
+		mockScheduledPodScalerRepository.EXPECT().
+			GetByName(gomock.Not(nil), types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			}).
+			Return(&scheduledPodScaler1, nil)
+		mockScheduledPodScalerRepository.EXPECT().
+			UpdateStatus(gomock.Not(nil), &scheduledpodscaler.ScheduledPodScaler{
+				ObjectMeta: scheduledPodScaler1.ObjectMeta,
+				Spec:       scheduledPodScaler1.Spec,
+				Status: scheduledpodscaler.Status{
+					ActiveRule:        "schedule[0]",
+					DesiredScaleSpec:  &scheduledPodScaler1.Spec.ScaleRules[0].ScaleSpec,
+					LastScaleTime:     time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+					NextReconcileTime: time.Date(2019, 12, 1, 19, 0, 0, 0, time.UTC),
+					Targets: []scheduledpodscaler.TargetStatus{
+						{
+							Namespace:     "fixture",
+							Name:          "server1",
+							Current:       scheduledpodscaler.ScaleSpec{Replicas: 40},
+							Desired:       scheduledpodscaler.ScaleSpec{Replicas: 10},
+							LastScaleTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+						},
+						{
+							Namespace: "fixture",
+							Name:      "server2",
+							Current:   scheduledpodscaler.ScaleSpec{Replicas: 7},
+							Skipped:   invalidBaselineMessage,
+						},
+					},
+					Conditions: []scheduledpodscaler.Condition{
+						{
+							Type:               scheduledpodscaler.ConditionScheduleValid,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Valid",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionSuspended,
+							Status:             kcore.ConditionFalse,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "NotSuspended",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionTargetsFound,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "TargetFound",
+							Message:            "found 2 Deployment by selector=app=server1",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionScaled,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Scaled",
+							Message:            "1 Deployment have the desired replicas and 1 skipped",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionReady,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Ready",
+						},
+					},
+				},
+			})
+
+		workload1 := workload.Workload{
+			TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "fixture",
+				Name:        "server1",
+				Annotations: map[string]string{scheduledpodscaler.BaselineReplicasAnnotation: "40"},
+			},
+			Replicas: 40,
+		}
+		workload2 := workload.Workload{
+			TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "fixture",
+				Name:        "server2",
+				Annotations: map[string]string{scheduledpodscaler.BaselineReplicasAnnotation: "abc"},
+			},
+			Replicas: 7,
+		}
+		mockWorkloadRepository := mock_workload.NewMockInterface(ctrl)
+		mockWorkloadRepository.EXPECT().
+			FindBySelectors(gomock.Not(nil), "fixture", deploymentGVK, labels.SelectorFromSet(labels.Set{"app": "server1"})).
+			Return([]workload.Workload{workload1, workload2}, nil)
+		mockWorkloadRepository.EXPECT().
+			Scale(gomock.Not(nil), &workload1, int32(10))
+
+		recorder := record.NewFakeRecorder(10)
+		m := metrics.New()
+		tc := testingClock(time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC))
+		r := Reconcile{
+			Log:                          testingLogr.TestLogger{T: t},
+			Clock:                        tc,
+			Recorder:                     recorder,
+			Metrics:                      m,
+			ScheduledPodScalerRepository: mockScheduledPodScalerRepository,
+			WorkloadRepository:           mockWorkloadRepository,
+		}
+		input := Input{
+			Target: types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			},
+		}
+		got, err := r.Do(ctx, input)
+		if err != nil {
+			t.Fatalf("Do error: %+v", err)
+		}
+		want := &Output{
+			NextReconcileAfter: 4 * time.Hour,
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want, +got):\n%s", diff)
+		}
+		if diff := cmp.Diff([]string{
+			"Normal Scaled Scaled fixture/server1 from 40 to 10 by rule schedule[0]",
+			"Normal Scaled Scaled fixture/server1 from 40 to 10 by rule schedule[0]",
+			"Warning InvalidAnnotation Skipped fixture/server2: " + invalidBaselineMessage,
+			"Warning InvalidAnnotation Skipped fixture/server2: " + invalidBaselineMessage,
+		}, receiveEvents(recorder)); diff != "" {
+			t.Errorf("events mismatch (-want, +got):\n%s", diff)
+		}
+	})
+
+	t.Run("RecordOriginalReplicas", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		scheduledPodScaler1 := scheduledpodscaler.ScheduledPodScaler{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "example1"},
+			Spec: scheduledpodscaler.Spec{
+				ScaleTarget: scheduledpodscaler.ScaleTarget{
+					GroupVersionKind: deploymentGVK,
+					Selector:         labels.SelectorFromSet(labels.Set{"app": "server1"}),
+				},
+				ScaleRules: []scheduledpodscaler.ScaleRule{
+					{
+						Range: &schedule.DailyRange{
+							StartTime: 12 * time.Hour,
+							EndTime:   19 * time.Hour,
+						},
+						Timezone: time.UTC,
+						ScaleSpec: scheduledpodscaler.ScaleSpec{
+							Replicas: 5,
+						},
+					},
+				},
+				DefaultMode: scheduledpodscaler.DefaultModeRestore,
+			},
+		}
+		mockScheduledPodScalerRepository := mock_scheduledpodscaler.NewMockInterface(ctrl)
+		mockScheduledPodScalerRepository.EXPECT().
+			GetByName(gomock.Not(nil), types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			}).
+			Return(&scheduledPodScaler1, nil)
+		mockScheduledPodScalerRepository.EXPECT().
+			UpdateStatus(gomock.Not(nil), &scheduledpodscaler.ScheduledPodScaler{
+				ObjectMeta: scheduledPodScaler1.ObjectMeta,
+				Spec:       scheduledPodScaler1.Spec,
+				Status: scheduledpodscaler.Status{
+					ActiveRule:        "schedule[0]",
+					DesiredScaleSpec:  &scheduledpodscaler.ScaleSpec{Replicas: 5},
+					LastScaleTime:     time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+					NextReconcileTime: time.Date(2019, 12, 1, 19, 0, 0, 0, time.UTC),
+					Targets: []scheduledpodscaler.TargetStatus{
+						{
+							Namespace:     "fixture",
+							Name:          "server1",
+							Current:       scheduledpodscaler.ScaleSpec{Replicas: 3},
+							Desired:       scheduledpodscaler.ScaleSpec{Replicas: 5},
+							LastScaleTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+						},
+					},
+					Conditions: []scheduledpodscaler.Condition{
+						{
+							Type:               scheduledpodscaler.ConditionScheduleValid,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Valid",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionSuspended,
+							Status:             kcore.ConditionFalse,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "NotSuspended",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionTargetsFound,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "TargetFound",
+							Message:            "found 1 Deployment by selector=app=server1",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionScaled,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Scaled",
+							Message:            "1 Deployment have the desired replicas",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionReady,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Ready",
+						},
+					},
+				},
+			})
+
+		workload1 := workload.Workload{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "server1"},
+			Replicas:   3,
+		}
+		mockWorkloadRepository := mock_workload.NewMockInterface(ctrl)
+		mockWorkloadRepository.EXPECT().
+			FindBySelectors(gomock.Not(nil), "fixture", deploymentGVK, labels.SelectorFromSet(labels.Set{"app": "server1"})).
+			Return([]workload.Workload{workload1}, nil)
+		mockWorkloadRepository.EXPECT().
+			Annotate(gomock.Not(nil), &workload1, scheduledpodscaler.OriginalReplicasAnnotation, "3")
+		mockWorkloadRepository.EXPECT().
+			Scale(gomock.Not(nil), &workload1, int32(5))
+
+		recorder := record.NewFakeRecorder(10)
+		tc := testingClock(time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC))
+		r := Reconcile{
+			Log:                          testingLogr.TestLogger{T: t},
+			Clock:                        tc,
+			Recorder:                     recorder,
+			Metrics:                      metrics.New(),
+			ScheduledPodScalerRepository: mockScheduledPodScalerRepository,
+			WorkloadRepository:           mockWorkloadRepository,
+		}
+		input := Input{
+			Target: types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			},
+		}
+		got, err := r.Do(ctx, input)
+		if err != nil {
+			t.Fatalf("Do error: %+v", err)
+		}
+		want := &Output{
+			NextReconcileAfter: 4 * time.Hour,
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want, +got):\n%s", diff)
+		}
+		if diff := cmp.Diff([]string{
+			"Normal Scaled Scaled fixture/server1 from 3 to 5 by rule schedule[0]",
+			"Normal Scaled Scaled fixture/server1 from 3 to 5 by rule schedule[0]",
+		}, receiveEvents(recorder)); diff != "" {
+			t.Errorf("events mismatch (-want, +got):\n%s", diff)
+		}
+	})
+
+	t.Run("RestoreOriginalReplicas", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		scheduledPodScaler1 := scheduledpodscaler.ScheduledPodScaler{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "example1"},
+			Spec: scheduledpodscaler.Spec{
+				ScaleTarget: scheduledpodscaler.ScaleTarget{
+					GroupVersionKind: deploymentGVK,
+					Selector:         labels.SelectorFromSet(labels.Set{"app": "server1"}),
+				},
+				ScaleRules: []scheduledpodscaler.ScaleRule{
+					{
+						Range: &schedule.DailyRange{
+							StartTime: 16 * time.Hour,
+							EndTime:   19 * time.Hour,
+						},
+						Timezone: time.UTC,
+						ScaleSpec: scheduledpodscaler.ScaleSpec{
+							Replicas: 5,
+						},
+					},
+				},
+				DefaultMode: scheduledpodscaler.DefaultModeRestore,
+			},
+		}
+		mockScheduledPodScalerRepository := mock_scheduledpodscaler.NewMockInterface(ctrl)
+		mockScheduledPodScalerRepository.EXPECT().
+			GetByName(gomock.Not(nil), types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			}).
+			Return(&scheduledPodScaler1, nil)
+		mockScheduledPodScalerRepository.EXPECT().
+			UpdateStatus(gomock.Not(nil), &scheduledpodscaler.ScheduledPodScaler{
+				ObjectMeta: scheduledPodScaler1.ObjectMeta,
+				Spec:       scheduledPodScaler1.Spec,
+				Status: scheduledpodscaler.Status{
+					ActiveRule:        "default",
+					LastScaleTime:     time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+					NextReconcileTime: time.Date(2019, 12, 1, 16, 0, 0, 0, time.UTC),
+					Targets: []scheduledpodscaler.TargetStatus{
+						{
+							Namespace:     "fixture",
+							Name:          "server1",
+							Current:       scheduledpodscaler.ScaleSpec{Replicas: 5},
+							Desired:       scheduledpodscaler.ScaleSpec{Replicas: 3},
+							LastScaleTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+						},
+					},
+					Conditions: []scheduledpodscaler.Condition{
+						{
+							Type:               scheduledpodscaler.ConditionScheduleValid,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Valid",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionSuspended,
+							Status:             kcore.ConditionFalse,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "NotSuspended",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionTargetsFound,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "TargetFound",
+							Message:            "found 1 Deployment by selector=app=server1",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionScaled,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Scaled",
+							Message:            "1 Deployment have the desired replicas",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionReady,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Ready",
+						},
+					},
+				},
+			})
+
+		workload1 := workload.Workload{
+			TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "fixture",
+				Name:        "server1",
+				Annotations: map[string]string{scheduledpodscaler.OriginalReplicasAnnotation: "3"},
+			},
+			Replicas: 5,
+		}
+		mockWorkloadRepository := mock_workload.NewMockInterface(ctrl)
+		mockWorkloadRepository.EXPECT().
+			FindBySelectors(gomock.Not(nil), "fixture", deploymentGVK, labels.SelectorFromSet(labels.Set{"app": "server1"})).
+			Return([]workload.Workload{workload1}, nil)
+		mockWorkloadRepository.EXPECT().
+			Scale(gomock.Not(nil), &workload1, int32(3)).
+			DoAndReturn(func(_ context.Context, w *workload.Workload, replicas int32) error {
+				w.Replicas = replicas
+				return nil
+			})
+		restoredWorkload1 := workload1
+		restoredWorkload1.Replicas = 3
+		mockWorkloadRepository.EXPECT().
+			RemoveAnnotation(gomock.Not(nil), &restoredWorkload1, scheduledpodscaler.OriginalReplicasAnnotation)
+
+		recorder := record.NewFakeRecorder(10)
+		tc := testingClock(time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC))
+		r := Reconcile{
+			Log:                          testingLogr.TestLogger{T: t},
+			Clock:                        tc,
+			Recorder:                     recorder,
+			Metrics:                      metrics.New(),
+			ScheduledPodScalerRepository: mockScheduledPodScalerRepository,
+			WorkloadRepository:           mockWorkloadRepository,
+		}
+		input := Input{
+			Target: types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			},
+		}
+		got, err := r.Do(ctx, input)
+		if err != nil {
+			t.Fatalf("Do error: %+v", err)
+		}
+		want := &Output{
+			NextReconcileAfter: time.Hour,
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want, +got):\n%s", diff)
+		}
+		if diff := cmp.Diff([]string{
+			"Normal Scaled Scaled fixture/server1 from 5 to 3 by rule default",
+			"Normal Scaled Scaled fixture/server1 from 5 to 3 by rule default",
+		}, receiveEvents(recorder)); diff != "" {
+			t.Errorf("events mismatch (-want, +got):\n%s", diff)
+		}
+	})
+
+	t.Run("AtLeastDeployment", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		scheduledPodScaler1 := scheduledpodscaler.ScheduledPodScaler{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "example1"},
+			Spec: scheduledpodscaler.Spec{
+				ScaleTarget: scheduledpodscaler.ScaleTarget{
+					GroupVersionKind: deploymentGVK,
+					Selector:         labels.SelectorFromSet(labels.Set{"app": "server1"}),
+				},
+				ScaleRules: []scheduledpodscaler.ScaleRule{
+					{
+						Range: &schedule.DailyRange{
+							StartTime: 12 * time.Hour,
+							EndTime:   19 * time.Hour,
+						},
+						Timezone: time.UTC,
+						ScaleSpec: scheduledpodscaler.ScaleSpec{
+							Replicas: 5,
+						},
+						Mode: scheduledpodscaler.ScaleModeAtLeast,
+					},
+				},
+			},
+		}
+		mockScheduledPodScalerRepository := mock_scheduledpodscaler.NewMockInterface(ctrl)
+		mockScheduledPodScalerRepository.EXPECT().
+			GetByName(gomock.Not(nil), types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			}).
+			Return(&scheduledPodScaler1, nil)
+		mockScheduledPodScalerRepository.EXPECT().
+			UpdateStatus(gomock.Not(nil), &scheduledpodscaler.ScheduledPodScaler{
+				ObjectMeta: scheduledPodScaler1.ObjectMeta,
+				Spec:       scheduledPodScaler1.Spec,
+				Status: scheduledpodscaler.Status{
+					ActiveRule:        "schedule[0]",
+					DesiredScaleSpec:  &scheduledpodscaler.ScaleSpec{Replicas: 5},
+					NextReconcileTime: time.Date(2019, 12, 1, 19, 0, 0, 0, time.UTC),
+					Targets: []scheduledpodscaler.TargetStatus{
+						{
+							Namespace: "fixture",
+							Name:      "server1",
+							Current:   scheduledpodscaler.ScaleSpec{Replicas: 10},
+							Desired:   scheduledpodscaler.ScaleSpec{Replicas: 10},
+						},
+					},
+					Conditions: []scheduledpodscaler.Condition{
+						{
+							Type:               scheduledpodscaler.ConditionScheduleValid,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Valid",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionSuspended,
+							Status:             kcore.ConditionFalse,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "NotSuspended",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionTargetsFound,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "TargetFound",
+							Message:            "found 1 Deployment by selector=app=server1",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionScaled,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Scaled",
+							Message:            "1 Deployment have the desired replicas",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionReady,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Ready",
+						},
+					},
+				},
+			})
+
+		workload1 := workload.Workload{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "server1"},
+			Replicas:   10,
+		}
+		mockWorkloadRepository := mock_workload.NewMockInterface(ctrl)
+		mockWorkloadRepository.EXPECT().
+			FindBySelectors(gomock.Not(nil), "fixture", deploymentGVK, labels.SelectorFromSet(labels.Set{"app": "server1"})).
+			Return([]workload.Workload{workload1}, nil)
+
+		recorder := record.NewFakeRecorder(10)
+		m := metrics.New()
+		tc := testingClock(time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC))
+		r := Reconcile{
+			Log:                          testingLogr.TestLogger{T: t},
+			Clock:                        tc,
+			Recorder:                     recorder,
+			Metrics:                      m,
+			ScheduledPodScalerRepository: mockScheduledPodScalerRepository,
+			WorkloadRepository:           mockWorkloadRepository,
+		}
+		input := Input{
+			Target: types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			},
+		}
+		got, err := r.Do(ctx, input)
+		if err != nil {
+			t.Fatalf("Do error: %+v", err)
+		}
+		want := &Output{
+			NextReconcileAfter: 4 * time.Hour,
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want, +got):\n%s", diff)
+		}
+		if events := receiveEvents(recorder); len(events) > 0 {
+			t.Errorf("events wants empty but %v", events)
+		}
+		if got := testutil.ToFloat64(m.DesiredReplicas.WithLabelValues("fixture", "example1", "fixture", "server1")); got != 10 {
+			t.Errorf("desired replicas wants 10 but %v", got)
+		}
+	})
+
+	t.Run("NotScaleDeployment", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		scheduledPodScaler1 := scheduledpodscaler.ScheduledPodScaler{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "example1"},
+			Spec: scheduledpodscaler.Spec{
+				ScaleTarget: scheduledpodscaler.ScaleTarget{
+					GroupVersionKind: deploymentGVK,
+					Selector:         labels.SelectorFromSet(labels.Set{"app": "server1"}),
+				},
+				ScaleRules: []scheduledpodscaler.ScaleRule{
+					{
+						Range: &schedule.DailyRange{
+							StartTime: 12 * time.Hour,
+							EndTime:   19 * time.Hour,
+						},
+						Timezone: time.UTC,
+						ScaleSpec: scheduledpodscaler.ScaleSpec{
+							Replicas: 5,
+						},
+					},
+				},
+			},
+		}
+		mockScheduledPodScalerRepository := mock_scheduledpodscaler.NewMockInterface(ctrl)
+		mockScheduledPodScalerRepository.EXPECT().
+			GetByName(gomock.Not(nil), types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			}).
+			Return(&scheduledPodScaler1, nil)
+		mockScheduledPodScalerRepository.EXPECT().
+			UpdateStatus(gomock.Not(nil), &scheduledpodscaler.ScheduledPodScaler{
+				ObjectMeta: scheduledPodScaler1.ObjectMeta,
+				Spec:       scheduledPodScaler1.Spec,
+				Status: scheduledpodscaler.Status{
+					ActiveRule:        "schedule[0]",
+					DesiredScaleSpec:  &scheduledpodscaler.ScaleSpec{Replicas: 5},
+					NextReconcileTime: time.Date(2019, 12, 1, 19, 0, 0, 0, time.UTC),
+					Targets: []scheduledpodscaler.TargetStatus{
+						{
+							Namespace: "fixture",
+							Name:      "server1",
+							Current:   scheduledpodscaler.ScaleSpec{Replicas: 5},
+							Desired:   scheduledpodscaler.ScaleSpec{Replicas: 5},
+						},
+					},
+					Conditions: []scheduledpodscaler.Condition{
+						{
+							Type:               scheduledpodscaler.ConditionScheduleValid,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Valid",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionSuspended,
+							Status:             kcore.ConditionFalse,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "NotSuspended",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionTargetsFound,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "TargetFound",
+							Message:            "found 1 Deployment by selector=app=server1",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionScaled,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Scaled",
+							Message:            "1 Deployment have the desired replicas",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionReady,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Ready",
+						},
+					},
+				},
+			})
+
+		workload1 := workload.Workload{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "server1"},
+			Replicas:   5,
+		}
+		mockWorkloadRepository := mock_workload.NewMockInterface(ctrl)
+		mockWorkloadRepository.EXPECT().
+			FindBySelectors(gomock.Not(nil), "fixture", deploymentGVK, labels.SelectorFromSet(labels.Set{"app": "server1"})).
+			Return([]workload.Workload{workload1}, nil)
+
+		recorder := record.NewFakeRecorder(10)
+		tc := testingClock(time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC))
+		r := Reconcile{
+			Log:                          testingLogr.TestLogger{T: t},
+			Clock:                        tc,
+			Recorder:                     recorder,
+			Metrics:                      metrics.New(),
+			ScheduledPodScalerRepository: mockScheduledPodScalerRepository,
+			WorkloadRepository:           mockWorkloadRepository,
+		}
+		input := Input{
+			Target: types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			},
+		}
+		got, err := r.Do(ctx, input)
+		if err != nil {
+			t.Fatalf("Do error: %+v", err)
+		}
+		want := &Output{
+			NextReconcileAfter: 4 * time.Hour,
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want, +got):\n%s", diff)
+		}
+		if diff := cmp.Diff([]string(nil), receiveEvents(recorder)); diff != "" {
+			t.Errorf("events mismatch (-want, +got):\n%s", diff)
+		}
+	})
+
+	t.Run("IgnoreDeployment", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		scheduledPodScaler1 := scheduledpodscaler.ScheduledPodScaler{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "example1"},
+			Spec: scheduledpodscaler.Spec{
+				ScaleTarget: scheduledpodscaler.ScaleTarget{
+					GroupVersionKind: deploymentGVK,
+					Selector:         labels.SelectorFromSet(labels.Set{"app": "server1"}),
+				},
+				DefaultScaleSpec: scheduledpodscaler.ScaleSpec{
+					Replicas: 5,
+				},
+			},
+		}
+		mockScheduledPodScalerRepository := mock_scheduledpodscaler.NewMockInterface(ctrl)
+		mockScheduledPodScalerRepository.EXPECT().
+			GetByName(gomock.Not(nil), types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			}).
+			Return(&scheduledPodScaler1, nil)
+		mockScheduledPodScalerRepository.EXPECT().
+			UpdateStatus(gomock.Not(nil), &scheduledpodscaler.ScheduledPodScaler{
+				ObjectMeta: scheduledPodScaler1.ObjectMeta,
+				Spec:       scheduledPodScaler1.Spec,
+				Status: scheduledpodscaler.Status{
+					ActiveRule:       "default",
+					DesiredScaleSpec: &scheduledpodscaler.ScaleSpec{Replicas: 5},
+					LastScaleTime:    time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+					Targets: []scheduledpodscaler.TargetStatus{
+						{
+							Namespace:     "fixture",
+							Name:          "server1",
+							Current:       scheduledpodscaler.ScaleSpec{Replicas: 3},
+							Desired:       scheduledpodscaler.ScaleSpec{Replicas: 5},
+							LastScaleTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+						},
+						{
+							Namespace: "fixture",
+							Name:      "server2",
+							Current:   scheduledpodscaler.ScaleSpec{Replicas: 3},
+							Desired:   scheduledpodscaler.ScaleSpec{Replicas: 5},
+							Skipped:   "ignored by the annotation scheduledscaling.int128.github.io/ignore",
+						},
+					},
+					Conditions: []scheduledpodscaler.Condition{
+						{
+							Type:               scheduledpodscaler.ConditionScheduleValid,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Valid",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionSuspended,
+							Status:             kcore.ConditionFalse,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "NotSuspended",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionTargetsFound,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "TargetFound",
+							Message:            "found 2 Deployment by selector=app=server1",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionScaled,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Scaled",
+							Message:            "1 Deployment have the desired replicas and 1 skipped",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionReady,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Ready",
+						},
+					},
+				},
+			})
+
+		workload1 := workload.Workload{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "server1"},
+			Replicas:   3,
+		}
+		workload2 := workload.Workload{
+			TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "fixture",
+				Name:        "server2",
+				Annotations: map[string]string{"scheduledscaling.int128.github.io/ignore": "true"},
+			},
+			Replicas: 3,
+		}
+		mockWorkloadRepository := mock_workload.NewMockInterface(ctrl)
+		mockWorkloadRepository.EXPECT().
+			FindBySelectors(gomock.Not(nil), "fixture", deploymentGVK, labels.SelectorFromSet(labels.Set{"app": "server1"})).
+			Return([]workload.Workload{workload1, workload2}, nil)
+		mockWorkloadRepository.EXPECT().
+			Scale(gomock.Not(nil), &workload1, int32(5))
+
+		recorder := record.NewFakeRecorder(10)
+		tc := testingClock(time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC))
+		r := Reconcile{
+			Log:                          testingLogr.TestLogger{T: t},
+			Clock:                        tc,
+			Recorder:                     recorder,
+			Metrics:                      metrics.New(),
+			ScheduledPodScalerRepository: mockScheduledPodScalerRepository,
+			WorkloadRepository:           mockWorkloadRepository,
+		}
+		input := Input{
+			Target: types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			},
+		}
+		got, err := r.Do(ctx, input)
+		if err != nil {
+			t.Fatalf("Do error: %+v", err)
+		}
+		want := &Output{
+			NextReconcileAfter: 0,
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want, +got):\n%s", diff)
+		}
+	})
+
+	t.Run("DeleteMetricsOfStaleTargets", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		scheduledPodScaler1 := scheduledpodscaler.ScheduledPodScaler{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "example1"},
+			Spec: scheduledpodscaler.Spec{
+				ScaleTarget: scheduledpodscaler.ScaleTarget{
+					GroupVersionKind: deploymentGVK,
+					Selector:         labels.SelectorFromSet(labels.Set{"app": "server1"}),
+				},
+				DefaultScaleSpec: scheduledpodscaler.ScaleSpec{
+					Replicas: 3,
+				},
+			},
+			Status: scheduledpodscaler.Status{
+				Targets: []scheduledpodscaler.TargetStatus{
+					{Namespace: "fixture", Name: "server1"},
+					{Namespace: "fixture", Name: "server2"},
+					{Namespace: "fixture", Name: "server3"},
+				},
+			},
+		}
+		mockScheduledPodScalerRepository := mock_scheduledpodscaler.NewMockInterface(ctrl)
+		mockScheduledPodScalerRepository.EXPECT().
+			GetByName(gomock.Not(nil), types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			}).
+			Return(&scheduledPodScaler1, nil)
+		mockScheduledPodScalerRepository.EXPECT().
+			UpdateStatus(gomock.Not(nil), gomock.Any())
+
+		workload1 := workload.Workload{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "server1"},
+			Replicas:   3,
+		}
+		workload2 := workload.Workload{
+			TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "fixture",
+				Name:        "server2",
+				Annotations: map[string]string{"scheduledscaling.int128.github.io/ignore": "true"},
+			},
+			Replicas: 3,
+		}
+		mockWorkloadRepository := mock_workload.NewMockInterface(ctrl)
+		mockWorkloadRepository.EXPECT().
+			FindBySelectors(gomock.Not(nil), "fixture", deploymentGVK, labels.SelectorFromSet(labels.Set{"app": "server1"})).
+			Return([]workload.Workload{workload1, workload2}, nil)
+
+		m := metrics.New()
+		for _, name := range []string{"server1", "server2", "server3"} {
+			m.ObserveReplicas(types.NamespacedName{Namespace: "fixture", Name: "example1"}, types.NamespacedName{Namespace: "fixture", Name: name}, 1, 1)
+		}
+		recorder := record.NewFakeRecorder(10)
+		tc := testingClock(time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC))
+		r := Reconcile{
+			Log:                          testingLogr.TestLogger{T: t},
+			Clock:                        tc,
+			Recorder:                     recorder,
+			Metrics:                      m,
+			ScheduledPodScalerRepository: mockScheduledPodScalerRepository,
+			WorkloadRepository:           mockWorkloadRepository,
+		}
+		input := Input{
+			Target: types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			},
+		}
+		if _, err := r.Do(ctx, input); err != nil {
+			t.Fatalf("Do error: %+v", err)
+		}
+		want := `
+# HELP scheduled_scaler_target_desired_replicas Desired replicas of the target computed by the schedule.
+# TYPE scheduled_scaler_target_desired_replicas gauge
+scheduled_scaler_target_desired_replicas{name="example1",namespace="fixture",target_name="server1",target_namespace="fixture"} 3
+`
+		if err := testutil.CollectAndCompare(m.DesiredReplicas, strings.NewReader(want)); err != nil {
+			t.Errorf("CollectAndCompare error: %s", err)
+		}
+	})
+
+	t.Run("ScaleDeploymentInNamespaces", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		namespaceSelector := labels.SelectorFromSet(labels.Set{"team": "a"})
+		scheduledPodScaler1 := scheduledpodscaler.ScheduledPodScaler{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "example1"},
+			Spec: scheduledpodscaler.Spec{
+				ScaleTarget: scheduledpodscaler.ScaleTarget{
+					GroupVersionKind:  deploymentGVK,
+					Selector:          labels.SelectorFromSet(labels.Set{"app": "server1"}),
+					NamespaceSelector: namespaceSelector,
+				},
+				ScaleRules: []scheduledpodscaler.ScaleRule{
+					{
+						Range: &schedule.DailyRange{
+							StartTime: 12 * time.Hour,
+							EndTime:   19 * time.Hour,
+						},
+						Timezone: time.UTC,
+						ScaleSpec: scheduledpodscaler.ScaleSpec{
+							Replicas: 5,
+						},
+					},
+				},
+			},
+		}
+		mockScheduledPodScalerRepository := mock_scheduledpodscaler.NewMockInterface(ctrl)
+		mockScheduledPodScalerRepository.EXPECT().
+			GetByName(gomock.Not(nil), types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			}).
+			Return(&scheduledPodScaler1, nil)
+		mockScheduledPodScalerRepository.EXPECT().
+			UpdateStatus(gomock.Not(nil), &scheduledpodscaler.ScheduledPodScaler{
+				ObjectMeta: scheduledPodScaler1.ObjectMeta,
+				Spec:       scheduledPodScaler1.Spec,
+				Status: scheduledpodscaler.Status{
+					ActiveRule:        "schedule[0]",
+					DesiredScaleSpec:  &scheduledpodscaler.ScaleSpec{Replicas: 5},
+					LastScaleTime:     time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+					NextReconcileTime: time.Date(2019, 12, 1, 19, 0, 0, 0, time.UTC),
+					Targets: []scheduledpodscaler.TargetStatus{
+						{
+							Namespace:     "team-a1",
+							Name:          "server1",
+							Current:       scheduledpodscaler.ScaleSpec{Replicas: 3},
+							Desired:       scheduledpodscaler.ScaleSpec{Replicas: 5},
+							LastScaleTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+						},
+						{
+							Namespace:     "team-a2",
+							Name:          "server1",
+							Current:       scheduledpodscaler.ScaleSpec{Replicas: 3},
+							Desired:       scheduledpodscaler.ScaleSpec{Replicas: 5},
+							LastScaleTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+						},
+					},
+					Conditions: []scheduledpodscaler.Condition{
+						{
+							Type:               scheduledpodscaler.ConditionScheduleValid,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Valid",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionSuspended,
+							Status:             kcore.ConditionFalse,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "NotSuspended",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionTargetsFound,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "TargetFound",
+							Message:            "found 2 Deployment by selector=app=server1",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionScaled,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Scaled",
+							Message:            "2 Deployment have the desired replicas",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionReady,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Ready",
+						},
+					},
+				},
+			})
+
+		mockNamespaceRepository := mock_namespace.NewMockInterface(ctrl)
+		mockNamespaceRepository.EXPECT().
+			FindBySelector(gomock.Not(nil), namespaceSelector).
+			Return(&kcore.NamespaceList{
+				Items: []kcore.Namespace{
+					{ObjectMeta: metav1.ObjectMeta{Name: "team-a1"}},
+					{ObjectMeta: metav1.ObjectMeta{Name: "team-a2"}},
+				},
+			}, nil)
+
+		workload1 := workload.Workload{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "team-a1", Name: "server1"},
+			Replicas:   3,
+		}
+		workload2 := workload.Workload{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "team-a2", Name: "server1"},
+			Replicas:   3,
+		}
+		mockWorkloadRepository := mock_workload.NewMockInterface(ctrl)
+		mockWorkloadRepository.EXPECT().
+			FindBySelectors(gomock.Not(nil), "team-a1", deploymentGVK, labels.SelectorFromSet(labels.Set{"app": "server1"})).
+			Return([]workload.Workload{workload1}, nil)
+		mockWorkloadRepository.EXPECT().
+			FindBySelectors(gomock.Not(nil), "team-a2", deploymentGVK, labels.SelectorFromSet(labels.Set{"app": "server1"})).
+			Return([]workload.Workload{workload2}, nil)
+		mockWorkloadRepository.EXPECT().
+			Scale(gomock.Not(nil), &workload1, int32(5))
+		mockWorkloadRepository.EXPECT().
+			Scale(gomock.Not(nil), &workload2, int32(5))
+
+		recorder := record.NewFakeRecorder(10)
+		tc := testingClock(time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC))
+		r := Reconcile{
+			Log:                          testingLogr.TestLogger{T: t},
+			Clock:                        tc,
+			Recorder:                     recorder,
+			Metrics:                      metrics.New(),
+			ScheduledPodScalerRepository: mockScheduledPodScalerRepository,
+			WorkloadRepository:           mockWorkloadRepository,
+			NamespaceRepository:          mockNamespaceRepository,
+		}
+		input := Input{
+			Target: types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			},
+		}
+		got, err := r.Do(ctx, input)
+		if err != nil {
+			t.Fatalf("Do error: %+v", err)
+		}
+		want := &Output{
+			NextReconcileAfter: 4 * time.Hour,
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want, +got):\n%s", diff)
+		}
+	})
+
+	t.Run("ScaleHorizontalPodAutoscaler", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		scheduledPodScaler1 := scheduledpodscaler.ScheduledPodScaler{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "example1"},
+			Spec: scheduledpodscaler.Spec{
+				ScaleTarget: scheduledpodscaler.ScaleTarget{
+					GroupVersionKind: schema.GroupVersionKind{Group: "autoscaling", Version: "v1", Kind: "HorizontalPodAutoscaler"},
+					Selector:         labels.SelectorFromSet(labels.Set{"app": "server1"}),
+				},
+				ScaleRules: []scheduledpodscaler.ScaleRule{
+					{
+						Range: &schedule.DailyRange{
+							StartTime: 12 * time.Hour,
+							EndTime:   19 * time.Hour,
+						},
+						Timezone: time.UTC,
+						ScaleSpec: scheduledpodscaler.ScaleSpec{
+							MinReplicas: pointer.Int32Ptr(5),
+							MaxReplicas: pointer.Int32Ptr(10),
+						},
+					},
+				},
+			},
+		}
+		mockScheduledPodScalerRepository := mock_scheduledpodscaler.NewMockInterface(ctrl)
+		mockScheduledPodScalerRepository.EXPECT().
+			GetByName(gomock.Not(nil), types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			}).
+			Return(&scheduledPodScaler1, nil)
+		mockScheduledPodScalerRepository.EXPECT().
+			UpdateStatus(gomock.Not(nil), &scheduledpodscaler.ScheduledPodScaler{
+				ObjectMeta: scheduledPodScaler1.ObjectMeta,
+				Spec:       scheduledPodScaler1.Spec,
+				Status: scheduledpodscaler.Status{
+					ActiveRule:        "schedule[0]",
+					DesiredScaleSpec:  &scheduledpodscaler.ScaleSpec{MinReplicas: pointer.Int32Ptr(5), MaxReplicas: pointer.Int32Ptr(10)},
+					LastScaleTime:     time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+					NextReconcileTime: time.Date(2019, 12, 1, 19, 0, 0, 0, time.UTC),
+					Targets: []scheduledpodscaler.TargetStatus{
+						{
+							Namespace:     "fixture",
+							Name:          "server1",
+							Current:       scheduledpodscaler.ScaleSpec{MinReplicas: pointer.Int32Ptr(2), MaxReplicas: pointer.Int32Ptr(10)},
+							Desired:       scheduledpodscaler.ScaleSpec{MinReplicas: pointer.Int32Ptr(5), MaxReplicas: pointer.Int32Ptr(10)},
+							LastScaleTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+						},
+					},
+					Conditions: []scheduledpodscaler.Condition{
+						{
+							Type:               scheduledpodscaler.ConditionScheduleValid,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Valid",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionSuspended,
+							Status:             kcore.ConditionFalse,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "NotSuspended",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionTargetsFound,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "TargetFound",
+							Message:            "found 1 HorizontalPodAutoscaler by selector=app=server1",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionScaled,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Scaled",
+							Message:            "1 HorizontalPodAutoscaler have the desired replicas",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionReady,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Ready",
+						},
+					},
+				},
+			})
+
+		hpa1 := kautoscaling.HorizontalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "server1"},
+			Spec: kautoscaling.HorizontalPodAutoscalerSpec{
+				MinReplicas: pointer.Int32Ptr(2),
+				MaxReplicas: 10,
+			},
+		}
+		mockHorizontalPodAutoscalerRepository := mock_horizontalpodautoscaler.NewMockInterface(ctrl)
+		mockHorizontalPodAutoscalerRepository.EXPECT().
+			FindBySelectors(gomock.Not(nil), "fixture", labels.SelectorFromSet(labels.Set{"app": "server1"})).
+			Return(&kautoscaling.HorizontalPodAutoscalerList{
+				Items: []kautoscaling.HorizontalPodAutoscaler{hpa1},
+			}, nil)
+		mockHorizontalPodAutoscalerRepository.EXPECT().
+			Scale(gomock.Not(nil), &hpa1, pointer.Int32Ptr(5), nil)
+
+		recorder := record.NewFakeRecorder(10)
+		tc := testingClock(time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC))
+		r := Reconcile{
+			Log:                               testingLogr.TestLogger{T: t},
+			Clock:                             tc,
+			Recorder:                          recorder,
+			Metrics:                           metrics.New(),
+			ScheduledPodScalerRepository:      mockScheduledPodScalerRepository,
+			HorizontalPodAutoscalerRepository: mockHorizontalPodAutoscalerRepository,
+		}
+		input := Input{
+			Target: types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			},
+		}
+		got, err := r.Do(ctx, input)
+		if err != nil {
+			t.Fatalf("Do error: %+v", err)
+		}
+		want := &Output{
+			NextReconcileAfter: 4 * time.Hour,
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want, +got):\n%s", diff)
+		}
+		if diff := cmp.Diff([]string{
+			"Normal Scaled Scaled fixture/server1 minReplicas from 2 to 5 by rule schedule[0]",
+			"Normal Scaled Scaled fixture/server1 minReplicas from 2 to 5 by rule schedule[0]",
+		}, receiveEvents(recorder)); diff != "" {
+			t.Errorf("events mismatch (-want, +got):\n%s", diff)
+		}
+	})
+
+	t.Run("ScaleDeploymentByName", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		scheduledPodScaler1 := scheduledpodscaler.ScheduledPodScaler{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "example1"},
+			Spec: scheduledpodscaler.Spec{
+				ScaleTarget: scheduledpodscaler.ScaleTarget{
+					GroupVersionKind: deploymentGVK,
+					Name:             "server1",
+				},
+				ScaleRules: []scheduledpodscaler.ScaleRule{
+					{
+						Range: &schedule.DailyRange{
+							StartTime: 12 * time.Hour,
+							EndTime:   19 * time.Hour,
+						},
+						Timezone: time.UTC,
+						ScaleSpec: scheduledpodscaler.ScaleSpec{
+							Replicas: 5,
+						},
+					},
+				},
+			},
+		}
+		mockScheduledPodScalerRepository := mock_scheduledpodscaler.NewMockInterface(ctrl)
+		mockScheduledPodScalerRepository.EXPECT().
+			GetByName(gomock.Not(nil), types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			}).
+			Return(&scheduledPodScaler1, nil)
+		mockScheduledPodScalerRepository.EXPECT().
+			UpdateStatus(gomock.Not(nil), &scheduledpodscaler.ScheduledPodScaler{
+				ObjectMeta: scheduledPodScaler1.ObjectMeta,
+				Spec:       scheduledPodScaler1.Spec,
+				Status: scheduledpodscaler.Status{
+					ActiveRule:        "schedule[0]",
+					DesiredScaleSpec:  &scheduledpodscaler.ScaleSpec{Replicas: 5},
+					LastScaleTime:     time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+					NextReconcileTime: time.Date(2019, 12, 1, 19, 0, 0, 0, time.UTC),
+					Targets: []scheduledpodscaler.TargetStatus{
+						{
+							Namespace:     "fixture",
+							Name:          "server1",
+							Current:       scheduledpodscaler.ScaleSpec{Replicas: 3},
+							Desired:       scheduledpodscaler.ScaleSpec{Replicas: 5},
+							LastScaleTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+						},
+					},
+					Conditions: []scheduledpodscaler.Condition{
+						{
+							Type:               scheduledpodscaler.ConditionScheduleValid,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Valid",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionSuspended,
+							Status:             kcore.ConditionFalse,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "NotSuspended",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionTargetsFound,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "TargetFound",
+							Message:            "found 1 Deployment by name=server1",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionScaled,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Scaled",
+							Message:            "1 Deployment have the desired replicas",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionReady,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Ready",
+						},
+					},
+				},
+			})
+
+		workload1 := workload.Workload{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "server1"},
+			Replicas:   3,
+		}
+		mockWorkloadRepository := mock_workload.NewMockInterface(ctrl)
+		mockWorkloadRepository.EXPECT().
+			GetByName(gomock.Not(nil), deploymentGVK, types.NamespacedName{Namespace: "fixture", Name: "server1"}).
+			Return(&workload1, nil)
+		mockWorkloadRepository.EXPECT().
+			Scale(gomock.Not(nil), &workload1, int32(5))
+
+		recorder := record.NewFakeRecorder(10)
+		tc := testingClock(time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC))
+		r := Reconcile{
+			Log:                          testingLogr.TestLogger{T: t},
+			Clock:                        tc,
+			Recorder:                     recorder,
+			Metrics:                      metrics.New(),
+			ScheduledPodScalerRepository: mockScheduledPodScalerRepository,
+			WorkloadRepository:           mockWorkloadRepository,
+		}
+		input := Input{
+			Target: types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			},
+		}
+		got, err := r.Do(ctx, input)
+		if err != nil {
+			t.Fatalf("Do error: %+v", err)
+		}
+		want := &Output{
+			NextReconcileAfter: 4 * time.Hour,
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want, +got):\n%s", diff)
+		}
+	})
+
+	t.Run("NamedTargetNotFound", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		scheduledPodScaler1 := scheduledpodscaler.ScheduledPodScaler{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "example1"},
+			Spec: scheduledpodscaler.Spec{
+				ScaleTarget: scheduledpodscaler.ScaleTarget{
+					GroupVersionKind: deploymentGVK,
+					Name:             "server1",
+				},
+				ScaleRules: []scheduledpodscaler.ScaleRule{
+					{
+						Range: &schedule.DailyRange{
+							StartTime: 12 * time.Hour,
+							EndTime:   19 * time.Hour,
+						},
+						Timezone: time.UTC,
+						ScaleSpec: scheduledpodscaler.ScaleSpec{
+							Replicas: 5,
+						},
+					},
+				},
+			},
+		}
+		mockScheduledPodScalerRepository := mock_scheduledpodscaler.NewMockInterface(ctrl)
+		mockScheduledPodScalerRepository.EXPECT().
+			GetByName(gomock.Not(nil), types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			}).
+			Return(&scheduledPodScaler1, nil)
+		mockScheduledPodScalerRepository.EXPECT().
+			UpdateStatus(gomock.Not(nil), &scheduledpodscaler.ScheduledPodScaler{
+				ObjectMeta: scheduledPodScaler1.ObjectMeta,
+				Spec:       scheduledPodScaler1.Spec,
+				Status: scheduledpodscaler.Status{
+					ActiveRule:        "schedule[0]",
+					DesiredScaleSpec:  &scheduledpodscaler.ScaleSpec{Replicas: 5},
+					NextReconcileTime: time.Date(2019, 12, 1, 19, 0, 0, 0, time.UTC),
+					Conditions: []scheduledpodscaler.Condition{
+						{
+							Type:               scheduledpodscaler.ConditionScheduleValid,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Valid",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionSuspended,
+							Status:             kcore.ConditionFalse,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "NotSuspended",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionTargetsFound,
+							Status:             kcore.ConditionFalse,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "TargetNotFound",
+							Message:            "no Deployment found by name=server1",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionScaled,
+							Status:             kcore.ConditionFalse,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "TargetNotFound",
+							Message:            "no target to scale",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionReady,
+							Status:             kcore.ConditionFalse,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "TargetNotFound",
+							Message:            "no Deployment found by name=server1",
+						},
+					},
+				},
+			})
+
+		mockWorkloadRepository := mock_workload.NewMockInterface(ctrl)
+		mockWorkloadRepository.EXPECT().
+			GetByName(gomock.Not(nil), deploymentGVK, types.NamespacedName{Namespace: "fixture", Name: "server1"}).
+			Return(nil, &aError{
+				error:     fmt.Errorf("not found error"),
+				temporary: true,
+				notFound:  true,
+			})
 
-func TestReconcile_Do(t *testing.T) {
-	ctx := context.TODO()
+		recorder := record.NewFakeRecorder(10)
+		tc := testingClock(time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC))
+		r := Reconcile{
+			Log:                          testingLogr.TestLogger{T: t},
+			Clock:                        tc,
+			Recorder:                     recorder,
+			Metrics:                      metrics.New(),
+			ScheduledPodScalerRepository: mockScheduledPodScalerRepository,
+			WorkloadRepository:           mockWorkloadRepository,
+		}
+		input := Input{
+			Target: types.NamespacedName{
+				Namespace: "fixture",
+				Name:      "example1",
+			},
+		}
+		got, err := r.Do(ctx, input)
+		if err != nil {
+			t.Fatalf("Do error: %+v", err)
+		}
+		want := &Output{
+			NextReconcileAfter: 4 * time.Hour,
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want, +got):\n%s", diff)
+		}
+	})
 
-	t.Run("ScaleDeployment", func(t *testing.T) {
+	t.Run("UnknownKind", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		scheduledPodScaler1 := scheduledpodscaler.ScheduledPodScaler{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "example1"},
 			Spec: scheduledpodscaler.Spec{
 				ScaleTarget: scheduledpodscaler.ScaleTarget{
-					Selectors: map[string]string{
-						"app": "server1",
-					},
+					GroupVersionKind: deploymentGVK,
+					Name:             "server1",
 				},
 				ScaleRules: []scheduledpodscaler.ScaleRule{
 					{
@@ -61,32 +2567,67 @@ func TestReconcile_Do(t *testing.T) {
 			Return(&scheduledPodScaler1, nil)
 		mockScheduledPodScalerRepository.EXPECT().
 			UpdateStatus(gomock.Not(nil), &scheduledpodscaler.ScheduledPodScaler{
-				Spec: scheduledPodScaler1.Spec,
+				ObjectMeta: scheduledPodScaler1.ObjectMeta,
+				Spec:       scheduledPodScaler1.Spec,
 				Status: scheduledpodscaler.Status{
+					ActiveRule:        "schedule[0]",
+					DesiredScaleSpec:  &scheduledpodscaler.ScaleSpec{Replicas: 5},
 					NextReconcileTime: time.Date(2019, 12, 1, 19, 0, 0, 0, time.UTC),
+					Conditions: []scheduledpodscaler.Condition{
+						{
+							Type:               scheduledpodscaler.ConditionScheduleValid,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Valid",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionSuspended,
+							Status:             kcore.ConditionFalse,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "NotSuspended",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionTargetsFound,
+							Status:             kcore.ConditionFalse,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "UnknownKind",
+							Message:            "could not get the Deployment in namespace fixture: no matches for kind \"Deploymnet\"",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionScaled,
+							Status:             kcore.ConditionFalse,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "UnknownKind",
+							Message:            "could not get the Deployment in namespace fixture: no matches for kind \"Deploymnet\"",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionReady,
+							Status:             kcore.ConditionFalse,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "UnknownKind",
+							Message:            "could not get the Deployment in namespace fixture: no matches for kind \"Deploymnet\"",
+						},
+					},
 				},
 			})
 
-		deployment1 := kapps.Deployment{
-			Spec: kapps.DeploymentSpec{
-				Replicas: pointer.Int32Ptr(3),
-			},
-		}
-		mockDeploymentRepository := mock_deployment.NewMockInterface(ctrl)
-		mockDeploymentRepository.EXPECT().
-			FindBySelectors(gomock.Not(nil), map[string]string{"app": "server1"}).
-			Return(&kapps.DeploymentList{
-				Items: []kapps.Deployment{deployment1},
-			}, nil)
-		mockDeploymentRepository.EXPECT().
-			Scale(gomock.Not(nil), &deployment1, int32(5))
+		mockWorkloadRepository := mock_workload.NewMockInterface(ctrl)
+		mockWorkloadRepository.EXPECT().
+			GetByName(gomock.Not(nil), deploymentGVK, types.NamespacedName{Namespace: "fixture", Name: "server1"}).
+			Return(nil, &aError{
+				error:       fmt.Errorf("no matches for kind \"Deploymnet\""),
+				unknownKind: true,
+			})
 
+		recorder := record.NewFakeRecorder(10)
 		tc := testingClock(time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC))
 		r := Reconcile{
 			Log:                          testingLogr.TestLogger{T: t},
 			Clock:                        tc,
+			Recorder:                     recorder,
+			Metrics:                      metrics.New(),
 			ScheduledPodScalerRepository: mockScheduledPodScalerRepository,
-			DeploymentRepository:         mockDeploymentRepository,
+			WorkloadRepository:           mockWorkloadRepository,
 		}
 		input := Input{
 			Target: types.NamespacedName{
@@ -106,22 +2647,22 @@ func TestReconcile_Do(t *testing.T) {
 		}
 	})
 
-	t.Run("NotScaleDeployment", func(t *testing.T) {
+	t.Run("ExpiredRule", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		scheduledPodScaler1 := scheduledpodscaler.ScheduledPodScaler{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "example1"},
 			Spec: scheduledpodscaler.Spec{
 				ScaleTarget: scheduledpodscaler.ScaleTarget{
-					Selectors: map[string]string{
-						"app": "server1",
-					},
+					GroupVersionKind: deploymentGVK,
+					Selector:         labels.SelectorFromSet(labels.Set{"app": "server1"}),
 				},
 				ScaleRules: []scheduledpodscaler.ScaleRule{
 					{
-						Range: &schedule.DailyRange{
-							StartTime: 12 * time.Hour,
-							EndTime:   19 * time.Hour,
+						Range: &schedule.AbsoluteRange{
+							StartTime: time.Date(2019, 11, 1, 0, 0, 0, 0, time.UTC),
+							EndTime:   time.Date(2019, 11, 2, 0, 0, 0, 0, time.UTC),
 						},
 						Timezone: time.UTC,
 						ScaleSpec: scheduledpodscaler.ScaleSpec{
@@ -129,6 +2670,9 @@ func TestReconcile_Do(t *testing.T) {
 						},
 					},
 				},
+				DefaultScaleSpec: scheduledpodscaler.ScaleSpec{
+					Replicas: 1,
+				},
 			},
 		}
 		mockScheduledPodScalerRepository := mock_scheduledpodscaler.NewMockInterface(ctrl)
@@ -140,30 +2684,79 @@ func TestReconcile_Do(t *testing.T) {
 			Return(&scheduledPodScaler1, nil)
 		mockScheduledPodScalerRepository.EXPECT().
 			UpdateStatus(gomock.Not(nil), &scheduledpodscaler.ScheduledPodScaler{
-				Spec: scheduledPodScaler1.Spec,
+				ObjectMeta: scheduledPodScaler1.ObjectMeta,
+				Spec:       scheduledPodScaler1.Spec,
 				Status: scheduledpodscaler.Status{
-					NextReconcileTime: time.Date(2019, 12, 1, 19, 0, 0, 0, time.UTC),
+					ActiveRule:       "default",
+					DesiredScaleSpec: &scheduledpodscaler.ScaleSpec{Replicas: 1},
+					LastScaleTime:    time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+					Targets: []scheduledpodscaler.TargetStatus{
+						{
+							Namespace:     "fixture",
+							Name:          "server1",
+							Current:       scheduledpodscaler.ScaleSpec{Replicas: 3},
+							Desired:       scheduledpodscaler.ScaleSpec{Replicas: 1},
+							LastScaleTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+						},
+					},
+					Conditions: []scheduledpodscaler.Condition{
+						{
+							Type:               scheduledpodscaler.ConditionScheduleValid,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Valid",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionSuspended,
+							Status:             kcore.ConditionFalse,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "NotSuspended",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionTargetsFound,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "TargetFound",
+							Message:            "found 1 Deployment by selector=app=server1",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionScaled,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Scaled",
+							Message:            "1 Deployment have the desired replicas",
+						},
+						{
+							Type:               scheduledpodscaler.ConditionReady,
+							Status:             kcore.ConditionTrue,
+							LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							Reason:             "Ready",
+						},
+					},
 				},
 			})
 
-		deployment1 := kapps.Deployment{
-			Spec: kapps.DeploymentSpec{
-				Replicas: pointer.Int32Ptr(5),
-			},
+		workload1 := workload.Workload{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "server1"},
+			Replicas:   3,
 		}
-		mockDeploymentRepository := mock_deployment.NewMockInterface(ctrl)
-		mockDeploymentRepository.EXPECT().
-			FindBySelectors(gomock.Not(nil), map[string]string{"app": "server1"}).
-			Return(&kapps.DeploymentList{
-				Items: []kapps.Deployment{deployment1},
-			}, nil)
+		mockWorkloadRepository := mock_workload.NewMockInterface(ctrl)
+		mockWorkloadRepository.EXPECT().
+			FindBySelectors(gomock.Not(nil), "fixture", deploymentGVK, labels.SelectorFromSet(labels.Set{"app": "server1"})).
+			Return([]workload.Workload{workload1}, nil)
+		mockWorkloadRepository.EXPECT().
+			Scale(gomock.Not(nil), &workload1, int32(1))
 
+		recorder := record.NewFakeRecorder(10)
 		tc := testingClock(time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC))
 		r := Reconcile{
 			Log:                          testingLogr.TestLogger{T: t},
 			Clock:                        tc,
+			Recorder:                     recorder,
+			Metrics:                      metrics.New(),
 			ScheduledPodScalerRepository: mockScheduledPodScalerRepository,
-			DeploymentRepository:         mockDeploymentRepository,
+			WorkloadRepository:           mockWorkloadRepository,
 		}
 		input := Input{
 			Target: types.NamespacedName{
@@ -176,7 +2769,7 @@ func TestReconcile_Do(t *testing.T) {
 			t.Fatalf("Do error: %+v", err)
 		}
 		want := &Output{
-			NextReconcileAfter: 4 * time.Hour,
+			NextReconcileAfter: 0,
 		}
 		if diff := cmp.Diff(want, got); diff != "" {
 			t.Errorf("mismatch (-want, +got):\n%s", diff)
@@ -184,6 +2777,449 @@ func TestReconcile_Do(t *testing.T) {
 	})
 
 	t.Run("Errors", func(t *testing.T) {
+		t.Run("InvalidSpec", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			scheduledPodScaler1 := scheduledpodscaler.ScheduledPodScaler{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "example1"},
+				Status: scheduledpodscaler.Status{
+					NextReconcileTime: time.Date(2019, 12, 1, 19, 0, 0, 0, time.UTC),
+				},
+			}
+			mockScheduledPodScalerRepository := mock_scheduledpodscaler.NewMockInterface(ctrl)
+			mockScheduledPodScalerRepository.EXPECT().
+				GetByName(gomock.Not(nil), types.NamespacedName{
+					Namespace: "fixture",
+					Name:      "example1",
+				}).
+				Return(&scheduledPodScaler1, &aError{
+					error:   fmt.Errorf("invalid spec: invalid timezone"),
+					invalid: true,
+				})
+			mockScheduledPodScalerRepository.EXPECT().
+				UpdateStatus(gomock.Not(nil), &scheduledpodscaler.ScheduledPodScaler{
+					ObjectMeta: scheduledPodScaler1.ObjectMeta,
+					Status: scheduledpodscaler.Status{
+						Conditions: []scheduledpodscaler.Condition{
+							{
+								Type:               scheduledpodscaler.ConditionScheduleValid,
+								Status:             kcore.ConditionFalse,
+								LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+								Reason:             "InvalidSpec",
+								Message:            "invalid spec: invalid timezone",
+							},
+							{
+								Type:               scheduledpodscaler.ConditionReady,
+								Status:             kcore.ConditionFalse,
+								LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+								Reason:             "InvalidSpec",
+								Message:            "invalid spec: invalid timezone",
+							},
+						},
+					},
+				})
+
+			recorder := record.NewFakeRecorder(10)
+			tc := testingClock(time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC))
+			r := Reconcile{
+				Log:                          testingLogr.TestLogger{T: t},
+				Clock:                        tc,
+				Recorder:                     recorder,
+				Metrics:                      metrics.New(),
+				ScheduledPodScalerRepository: mockScheduledPodScalerRepository,
+			}
+			input := Input{
+				Target: types.NamespacedName{
+					Namespace: "fixture",
+					Name:      "example1",
+				},
+			}
+			got, err := r.Do(ctx, input)
+			if err != nil {
+				t.Fatalf("Do error: %+v", err)
+			}
+			want := &Output{
+				NextReconcileAfter: 0,
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("mismatch (-want, +got):\n%s", diff)
+			}
+			if diff := cmp.Diff([]string{
+				"Warning InvalidSpec invalid spec: invalid timezone",
+			}, receiveEvents(recorder)); diff != "" {
+				t.Errorf("events mismatch (-want, +got):\n%s", diff)
+			}
+		})
+
+		t.Run("InvalidSpecOfHorizontalPodAutoscaler", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			targets := []scheduledpodscaler.TargetStatus{
+				{
+					Namespace:     "fixture",
+					Name:          "server1",
+					Current:       scheduledpodscaler.ScaleSpec{MinReplicas: pointer.Int32Ptr(1), MaxReplicas: pointer.Int32Ptr(10)},
+					Desired:       scheduledpodscaler.ScaleSpec{MinReplicas: pointer.Int32Ptr(5)},
+					LastScaleTime: time.Date(2019, 12, 1, 12, 0, 0, 0, time.UTC),
+				},
+			}
+			scheduledPodScaler1 := scheduledpodscaler.ScheduledPodScaler{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "example1"},
+				Status: scheduledpodscaler.Status{
+					NextReconcileTime: time.Date(2019, 12, 1, 19, 0, 0, 0, time.UTC),
+					ActiveRule:        "schedule[0]",
+					DesiredScaleSpec:  &scheduledpodscaler.ScaleSpec{MinReplicas: pointer.Int32Ptr(5)},
+					LastScaleTime:     time.Date(2019, 12, 1, 12, 0, 0, 0, time.UTC),
+					Targets:           targets,
+				},
+			}
+			mockScheduledPodScalerRepository := mock_scheduledpodscaler.NewMockInterface(ctrl)
+			mockScheduledPodScalerRepository.EXPECT().
+				GetByName(gomock.Not(nil), types.NamespacedName{
+					Namespace: "fixture",
+					Name:      "example1",
+				}).
+				Return(&scheduledPodScaler1, &aError{
+					error:   fmt.Errorf("invalid spec: invalid timezone"),
+					invalid: true,
+				})
+			mockScheduledPodScalerRepository.EXPECT().
+				UpdateStatus(gomock.Not(nil), &scheduledpodscaler.ScheduledPodScaler{
+					ObjectMeta: scheduledPodScaler1.ObjectMeta,
+					Status: scheduledpodscaler.Status{
+						LastScaleTime: time.Date(2019, 12, 1, 12, 0, 0, 0, time.UTC),
+						Targets:       targets,
+						Conditions: []scheduledpodscaler.Condition{
+							{
+								Type:               scheduledpodscaler.ConditionScheduleValid,
+								Status:             kcore.ConditionFalse,
+								LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+								Reason:             "InvalidSpec",
+								Message:            "invalid spec: invalid timezone",
+							},
+							{
+								Type:               scheduledpodscaler.ConditionReady,
+								Status:             kcore.ConditionFalse,
+								LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+								Reason:             "InvalidSpec",
+								Message:            "invalid spec: invalid timezone",
+							},
+						},
+					},
+				})
+
+			recorder := record.NewFakeRecorder(10)
+			tc := testingClock(time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC))
+			r := Reconcile{
+				Log:                          testingLogr.TestLogger{T: t},
+				Clock:                        tc,
+				Recorder:                     recorder,
+				Metrics:                      metrics.New(),
+				ScheduledPodScalerRepository: mockScheduledPodScalerRepository,
+			}
+			input := Input{
+				Target: types.NamespacedName{
+					Namespace: "fixture",
+					Name:      "example1",
+				},
+			}
+			got, err := r.Do(ctx, input)
+			if err != nil {
+				t.Fatalf("Do error: %+v", err)
+			}
+			want := &Output{
+				NextReconcileAfter: 0,
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("mismatch (-want, +got):\n%s", diff)
+			}
+			for _, ts := range scheduledPodScaler1.Status.Targets {
+				if !ts.IsHorizontalPodAutoscaler() {
+					t.Errorf("target %s/%s wants HorizontalPodAutoscaler in the status", ts.Namespace, ts.Name)
+				}
+			}
+		})
+
+		t.Run("PartialScaleFailed", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			previousScaleTime := time.Date(2019, 11, 30, 15, 0, 0, 0, time.UTC)
+			scheduledPodScaler1 := scheduledpodscaler.ScheduledPodScaler{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "example1"},
+				Spec: scheduledpodscaler.Spec{
+					ScaleTarget: scheduledpodscaler.ScaleTarget{
+						GroupVersionKind: deploymentGVK,
+						Selector:         labels.SelectorFromSet(labels.Set{"app": "server1"}),
+					},
+					DefaultScaleSpec: scheduledpodscaler.ScaleSpec{
+						Replicas: 1,
+					},
+				},
+				Status: scheduledpodscaler.Status{
+					LastScaleTime: previousScaleTime,
+					Targets: []scheduledpodscaler.TargetStatus{
+						{
+							Namespace:     "fixture",
+							Name:          "server2",
+							LastScaleTime: previousScaleTime,
+						},
+					},
+				},
+			}
+			mockScheduledPodScalerRepository := mock_scheduledpodscaler.NewMockInterface(ctrl)
+			mockScheduledPodScalerRepository.EXPECT().
+				GetByName(gomock.Not(nil), types.NamespacedName{
+					Namespace: "fixture",
+					Name:      "example1",
+				}).
+				Return(&scheduledPodScaler1, nil)
+			mockScheduledPodScalerRepository.EXPECT().
+				UpdateStatus(gomock.Not(nil), &scheduledpodscaler.ScheduledPodScaler{
+					ObjectMeta: scheduledPodScaler1.ObjectMeta,
+					Spec:       scheduledPodScaler1.Spec,
+					Status: scheduledpodscaler.Status{
+						ActiveRule:       "default",
+						DesiredScaleSpec: &scheduledpodscaler.ScaleSpec{Replicas: 1},
+						LastScaleTime:    time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+						Targets: []scheduledpodscaler.TargetStatus{
+							{
+								Namespace: "fixture",
+								Name:      "server1",
+								Current:   scheduledpodscaler.ScaleSpec{Replicas: 3},
+								Desired:   scheduledpodscaler.ScaleSpec{Replicas: 1},
+								Error:     "forbidden",
+							},
+							{
+								Namespace:     "fixture",
+								Name:          "server2",
+								Current:       scheduledpodscaler.ScaleSpec{Replicas: 1},
+								Desired:       scheduledpodscaler.ScaleSpec{Replicas: 1},
+								LastScaleTime: previousScaleTime,
+							},
+							{
+								Namespace:     "fixture",
+								Name:          "server3",
+								Current:       scheduledpodscaler.ScaleSpec{Replicas: 3},
+								Desired:       scheduledpodscaler.ScaleSpec{Replicas: 1},
+								LastScaleTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+							},
+						},
+						Conditions: []scheduledpodscaler.Condition{
+							{
+								Type:               scheduledpodscaler.ConditionScheduleValid,
+								Status:             kcore.ConditionTrue,
+								LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+								Reason:             "Valid",
+							},
+							{
+								Type:               scheduledpodscaler.ConditionSuspended,
+								Status:             kcore.ConditionFalse,
+								LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+								Reason:             "NotSuspended",
+							},
+							{
+								Type:               scheduledpodscaler.ConditionTargetsFound,
+								Status:             kcore.ConditionTrue,
+								LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+								Reason:             "TargetFound",
+								Message:            "found 3 Deployment by selector=app=server1",
+							},
+							{
+								Type:               scheduledpodscaler.ConditionScaled,
+								Status:             kcore.ConditionFalse,
+								LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+								Reason:             "ScaleFailed",
+								Message:            "could not scale 1 of 3 targets: could not scale the Deployment fixture/server1: forbidden",
+							},
+							{
+								Type:               scheduledpodscaler.ConditionReady,
+								Status:             kcore.ConditionFalse,
+								LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+								Reason:             "ScaleFailed",
+								Message:            "could not scale 1 of 3 targets: could not scale the Deployment fixture/server1: forbidden",
+							},
+						},
+					},
+				})
+
+			workload1 := workload.Workload{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+				ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "server1"},
+				Replicas:   3,
+			}
+			workload2 := workload.Workload{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+				ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "server2"},
+				Replicas:   1,
+			}
+			workload3 := workload.Workload{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+				ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "server3"},
+				Replicas:   3,
+			}
+			mockWorkloadRepository := mock_workload.NewMockInterface(ctrl)
+			mockWorkloadRepository.EXPECT().
+				FindBySelectors(gomock.Not(nil), "fixture", deploymentGVK, labels.SelectorFromSet(labels.Set{"app": "server1"})).
+				Return([]workload.Workload{workload1, workload2, workload3}, nil)
+			mockWorkloadRepository.EXPECT().
+				Scale(gomock.Not(nil), &workload1, int32(1)).
+				Return(fmt.Errorf("forbidden"))
+			mockWorkloadRepository.EXPECT().
+				Scale(gomock.Not(nil), &workload3, int32(1))
+
+			recorder := record.NewFakeRecorder(10)
+			m := metrics.New()
+			tc := testingClock(time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC))
+			r := Reconcile{
+				Log:                          testingLogr.TestLogger{T: t},
+				Clock:                        tc,
+				Recorder:                     recorder,
+				Metrics:                      m,
+				ScheduledPodScalerRepository: mockScheduledPodScalerRepository,
+				WorkloadRepository:           mockWorkloadRepository,
+			}
+			input := Input{
+				Target: types.NamespacedName{
+					Namespace: "fixture",
+					Name:      "example1",
+				},
+			}
+			_, err := r.Do(ctx, input)
+			if err == nil {
+				t.Fatalf("Do wants error but nil")
+			}
+			if got := testutil.ToFloat64(m.ScaleOperations.WithLabelValues("fixture", "example1", "success")); got != 1 {
+				t.Errorf("succeeded scale operations wants 1 but %v", got)
+			}
+			if got := testutil.ToFloat64(m.ScaleOperations.WithLabelValues("fixture", "example1", "failure")); got != 1 {
+				t.Errorf("failed scale operations wants 1 but %v", got)
+			}
+		})
+
+		t.Run("ScaleFailed", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			scheduledPodScaler1 := scheduledpodscaler.ScheduledPodScaler{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "example1"},
+				Spec: scheduledpodscaler.Spec{
+					ScaleTarget: scheduledpodscaler.ScaleTarget{
+						GroupVersionKind: deploymentGVK,
+						Name:             "server1",
+					},
+					DefaultScaleSpec: scheduledpodscaler.ScaleSpec{
+						Replicas: 1,
+					},
+				},
+			}
+			mockScheduledPodScalerRepository := mock_scheduledpodscaler.NewMockInterface(ctrl)
+			mockScheduledPodScalerRepository.EXPECT().
+				GetByName(gomock.Not(nil), types.NamespacedName{
+					Namespace: "fixture",
+					Name:      "example1",
+				}).
+				Return(&scheduledPodScaler1, nil)
+			mockScheduledPodScalerRepository.EXPECT().
+				UpdateStatus(gomock.Not(nil), &scheduledpodscaler.ScheduledPodScaler{
+					ObjectMeta: scheduledPodScaler1.ObjectMeta,
+					Spec:       scheduledPodScaler1.Spec,
+					Status: scheduledpodscaler.Status{
+						ActiveRule:       "default",
+						DesiredScaleSpec: &scheduledpodscaler.ScaleSpec{Replicas: 1},
+						Targets: []scheduledpodscaler.TargetStatus{
+							{
+								Namespace: "fixture",
+								Name:      "server1",
+								Current:   scheduledpodscaler.ScaleSpec{Replicas: 3},
+								Desired:   scheduledpodscaler.ScaleSpec{Replicas: 1},
+								Error:     "forbidden",
+							},
+						},
+						Conditions: []scheduledpodscaler.Condition{
+							{
+								Type:               scheduledpodscaler.ConditionScheduleValid,
+								Status:             kcore.ConditionTrue,
+								LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+								Reason:             "Valid",
+							},
+							{
+								Type:               scheduledpodscaler.ConditionSuspended,
+								Status:             kcore.ConditionFalse,
+								LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+								Reason:             "NotSuspended",
+							},
+							{
+								Type:               scheduledpodscaler.ConditionTargetsFound,
+								Status:             kcore.ConditionTrue,
+								LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+								Reason:             "TargetFound",
+								Message:            "found 1 Deployment by name=server1",
+							},
+							{
+								Type:               scheduledpodscaler.ConditionScaled,
+								Status:             kcore.ConditionFalse,
+								LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+								Reason:             "ScaleFailed",
+								Message:            "could not scale 1 of 1 targets: could not scale the Deployment fixture/server1: forbidden",
+							},
+							{
+								Type:               scheduledpodscaler.ConditionReady,
+								Status:             kcore.ConditionFalse,
+								LastTransitionTime: time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC),
+								Reason:             "ScaleFailed",
+								Message:            "could not scale 1 of 1 targets: could not scale the Deployment fixture/server1: forbidden",
+							},
+						},
+					},
+				})
+
+			workload1 := workload.Workload{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+				ObjectMeta: metav1.ObjectMeta{Namespace: "fixture", Name: "server1"},
+				Replicas:   3,
+			}
+			mockWorkloadRepository := mock_workload.NewMockInterface(ctrl)
+			mockWorkloadRepository.EXPECT().
+				GetByName(gomock.Not(nil), deploymentGVK, types.NamespacedName{Namespace: "fixture", Name: "server1"}).
+				Return(&workload1, nil)
+			mockWorkloadRepository.EXPECT().
+				Scale(gomock.Not(nil), &workload1, int32(1)).
+				Return(&aError{error: fmt.Errorf("forbidden"), temporary: true})
+
+			recorder := record.NewFakeRecorder(10)
+			tc := testingClock(time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC))
+			r := Reconcile{
+				Log:                          testingLogr.TestLogger{T: t},
+				Clock:                        tc,
+				Recorder:                     recorder,
+				Metrics:                      metrics.New(),
+				ScheduledPodScalerRepository: mockScheduledPodScalerRepository,
+				WorkloadRepository:           mockWorkloadRepository,
+			}
+			input := Input{
+				Target: types.NamespacedName{
+					Namespace: "fixture",
+					Name:      "example1",
+				},
+			}
+			_, err := r.Do(ctx, input)
+			if err == nil {
+				t.Fatalf("Do wants error but nil")
+			}
+			if !errors.IsTemporary(err) {
+				t.Errorf("error wants temporary but not: %+v", err)
+			}
+			if diff := cmp.Diff([]string{
+				"Warning ScaleFailed Could not scale fixture/server1 to 1: forbidden",
+				"Warning ScaleFailed Could not scale fixture/server1 to 1: forbidden",
+			}, receiveEvents(recorder)); diff != "" {
+				t.Errorf("events mismatch (-want, +got):\n%s", diff)
+			}
+		})
+
 		t.Run("ScheduledPodScalerNotFound", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
@@ -199,10 +3235,17 @@ func TestReconcile_Do(t *testing.T) {
 					notFound:  true,
 				})
 
+			m := metrics.New()
+			m.ObserveReplicas(types.NamespacedName{Namespace: "fixture", Name: "example1"}, types.NamespacedName{Namespace: "fixture", Name: "server1"}, 1, 3)
+			m.IncScaleOperation(types.NamespacedName{Namespace: "fixture", Name: "example1"}, metrics.ScaleSucceeded)
+			m.ObserveNextReconcile(types.NamespacedName{Namespace: "fixture", Name: "example1"}, time.Hour)
+			recorder := record.NewFakeRecorder(10)
 			tc := testingClock(time.Date(2019, 12, 1, 15, 0, 0, 0, time.UTC))
 			r := Reconcile{
 				Log:                          testingLogr.TestLogger{T: t},
 				Clock:                        tc,
+				Recorder:                     recorder,
+				Metrics:                      m,
 				ScheduledPodScalerRepository: mockScheduledPodScalerRepository,
 			}
 			input := Input{
@@ -221,14 +3264,21 @@ func TestReconcile_Do(t *testing.T) {
 			if diff := cmp.Diff(want, got); diff != "" {
 				t.Errorf("mismatch (-want, +got):\n%s", diff)
 			}
+			for _, c := range []prometheus.Collector{m.CurrentReplicas, m.DesiredReplicas, m.ScaleOperations, m.NextReconcileSeconds} {
+				if err := testutil.CollectAndCompare(c, strings.NewReader("")); err != nil {
+					t.Errorf("CollectAndCompare error: %s", err)
+				}
+			}
 		})
 	})
 }
 
 type aError struct {
 	error
-	temporary bool
-	notFound  bool
+	temporary   bool
+	notFound    bool
+	invalid     bool
+	unknownKind bool
 }
 
 func (err *aError) IsTemporary() bool {
@@ -238,3 +3288,20 @@ func (err *aError) IsTemporary() bool {
 func (err *aError) IsNotFound() bool {
 	return err.notFound
 }
+
+func (err *aError) IsInvalid() bool {
+	return err.invalid
+}
+
+func (err *aError) IsUnknownKind() bool {
+	return err.unknownKind
+}
+
+func receiveEvents(recorder *record.FakeRecorder) []string {
+	close(recorder.Events)
+	var events []string
+	for event := range recorder.Events {
+		events = append(events, event)
+	}
+	return events
+}